@@ -0,0 +1,10 @@
+package apply
+
+import "errors"
+
+var (
+	ErrorMissingFile         = errors.New("A required flag is missing. Inform the path of the manifest with the --file flag and try again")
+	ErrorAutoApproveRequired = errors.New("Making the changes without a prompt requires approval. Review the plan and run the command again with the --auto-approve flag")
+	ErrorApply               = errors.New("Failed to apply the manifest: %s")
+	ErrorChange              = errors.New("failed to %s the %s %q: %w")
+)
@@ -0,0 +1,21 @@
+package apply
+
+var (
+	Usage            = "apply [flags]"
+	ShortDescription = "Creates, updates and deletes resources to match a manifest"
+	LongDescription  = "Compares a YAML or JSON manifest of an edge application with your account, displays the plan of the resources to create, update and delete, and makes the changes once you approve them. Resources are matched by the IDs recorded in the manifest or by their names, and the IDs of the resources are recorded in the manifest after the changes"
+	FlagFile         = "Path of the manifest file. Files ending in .json are read as JSON and the others as YAML"
+	FlagAutoApprove  = "Makes the changes of the plan without asking for approval"
+	FlagHelp         = "Displays more information about the apply command"
+
+	PlanHeader  = "Edge application %q:\n"
+	PlanSummary = "\nPlan: %d to create, %d to update, %d to delete\n"
+	NoChanges   = "The edge application %q already matches the manifest\n"
+	AskConfirm  = "Do you want to make these changes?"
+	Cancelled   = "No changes were made\n"
+	Applied     = "Applied: %d created, %d updated, %d deleted\n"
+	IDsRecorded = "The IDs of the resources were recorded in %s\n"
+	Created     = "Created the %s %q\n"
+	Updated     = "Updated the %s %q\n"
+	Deleted     = "Deleted the %s %q\n"
+)
@@ -11,4 +11,6 @@ var (
 	ErrorOpeningConfigFile     = errors.New("Failed to open the config.json file. The file doesn't exist, is corrupted, or has an invalid JSON format. Verify if the file format is JSON or fix its content according to the JSON format specification at https://www.json.org/json-en.html")
 	ErrorOpeningAzionFile      = errors.New("Failed to open the azion.json file. The file doesn't exist, is corrupted, or has an invalid JSON format. Verify if the file format is JSON or fix its content according to the JSON format specification at https://www.json.org/json-en.html")
 	ErrorEnvFileVulcan         = errors.New("Failed to read .env file generated by Vulcan during build process")
+	ErrorWatch                 = errors.New("Failed to watch the project files for changes. Verify if you have read permissions to the project directory and if the system limit of watched files was not reached and try again")
+	ErrorLoadingEnvFile        = errors.New("Failed to load the environment variables file '%s'. Verify if the file exists and is in the KEY=VALUE format and try again")
 )
@@ -13,4 +13,11 @@ var (
 	BuildNotNecessary     = "Skipping build step. There were no changes detected in your project"
 	FlagTemplate          = "The edge application's preset; Inform this flag if you wish to change the project's preset during build"
 	FlagMode              = "The edge application's mode; Inform this flag if you wish to change the project's mode during build"
+	FlagEnvFile           = "Path to a file with environment variables in the KEY=VALUE format to be loaded into the build process"
+	FlagWatch             = "Watches the project files and builds the edge application again every time a file changes"
+	BuildWatching         = "Watching for changes. Press Ctrl+C to stop\n"
+	BuildWatchRebuilding  = "Change detected, rebuilding your edge application\n"
+	BuildWatchRebuilt     = "Rebuilt in %s\n"
+	BuildWatchFailed      = "Build failed after %s: %s\n"
+	BuildCustomCmd        = "Running the build command configured in azion.json\n"
 )
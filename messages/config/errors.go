@@ -0,0 +1,9 @@
+package config
+
+import "errors"
+
+var (
+	ErrorUnknownKey      = errors.New("The setting '%s' is unknown. Run 'azion config list' to display the accepted settings and try again")
+	ErrorInvalidValue    = errors.New("The value '%s' is invalid for the setting '%s': %s")
+	ErrorWritingSettings = errors.New("Failed to write the config.yaml file of the CLI's configuration directory. Verify the permissions of the directory and try again")
+)
@@ -0,0 +1,37 @@
+package config
+
+var (
+	// [ config ]
+	ConfigUsage            = "config"
+	ConfigShortDescription = "Manages the settings of the CLI"
+	ConfigLongDescription  = "Manages the settings saved in the config.yaml file of the CLI's configuration directory. Environment variables and profiles take precedence over them"
+	ConfigFlagHelp         = "Displays more information about the config command"
+
+	// [ get ]
+	ConfigGetUsage            = "get <key> [flags]"
+	ConfigGetShortDescription = "Displays the value in use of a setting"
+	ConfigGetLongDescription  = "Displays the value in use of a setting, whether it comes from the environment, the profile, the config.yaml file or the default"
+	ConfigGetHelpFlag         = "Displays more information about the get subcommand"
+
+	// [ set ]
+	ConfigSetUsage            = "set <key> <value> [flags]"
+	ConfigSetShortDescription = "Saves a setting in the config.yaml file"
+	ConfigSetLongDescription  = "Validates a setting and saves it in the config.yaml file of the CLI's configuration directory"
+	ConfigSetHelpFlag         = "Displays more information about the set subcommand"
+	ConfigSetOutputSuccess    = "Setting %s saved as %s\n"
+
+	// [ unset ]
+	ConfigUnsetUsage            = "unset <key> [flags]"
+	ConfigUnsetShortDescription = "Removes a setting from the config.yaml file"
+	ConfigUnsetLongDescription  = "Removes a setting from the config.yaml file of the CLI's configuration directory, so its default value is used again"
+	ConfigUnsetHelpFlag         = "Displays more information about the unset subcommand"
+	ConfigUnsetOutputSuccess    = "Setting %s removed\n"
+	ConfigUnsetNotSet           = "Setting %s is not set in the config.yaml file\n"
+
+	// [ list ]
+	ConfigListUsage            = "list [flags]"
+	ConfigListShortDescription = "Displays the settings of the CLI"
+	ConfigListLongDescription  = "Displays every setting accepted by the CLI with the value in use and whether it is saved in the config.yaml file"
+	ConfigListHelpFlag         = "Displays more information about the list subcommand"
+	ConfigListSaved            = "saved"
+)
@@ -0,0 +1,9 @@
+package credentials
+
+import "errors"
+
+var (
+	ErrorPassphraseRequired = errors.New("Encrypting the tokens requires a passphrase. Set the AZIONCLI_CREDENTIALS_PASSPHRASE environment variable or run the command in an interactive terminal and try again")
+	ErrorPassphraseMismatch = errors.New("The passphrases don't match. Type the same passphrase twice and try again")
+	ErrorMigrate            = errors.New("Failed to encrypt the saved tokens: %s")
+)
@@ -0,0 +1,20 @@
+package credentials
+
+var (
+	// [ credentials ]
+	CredentialsUsage            = "credentials"
+	CredentialsShortDescription = "Manages how the CLI stores your personal tokens"
+	CredentialsLongDescription  = "Manages how the CLI stores the personal tokens of the credentials file and of the profiles"
+	CredentialsFlagHelp         = "Displays more information about the credentials command"
+
+	// [ migrate ]
+	CredentialsMigrateUsage            = "migrate [flags]"
+	CredentialsMigrateShortDescription = "Encrypts the plaintext tokens saved by the CLI"
+	CredentialsMigrateLongDescription  = "Encrypts the plaintext tokens of the credentials file and of the profiles with a passphrase read from the AZIONCLI_CREDENTIALS_PASSPHRASE environment variable or typed in a hidden prompt. Commands that call the Azion APIs decrypt the tokens with the same passphrase"
+	CredentialsMigrateHelpFlag         = "Displays more information about the migrate subcommand"
+	CredentialsMigrateAskPassphrase    = "Choose a passphrase to encrypt the tokens:"
+	CredentialsMigrateAskConfirm       = "Type the passphrase again:"
+	CredentialsMigrateEncrypted        = "Token encrypted in %s\n"
+	CredentialsMigrateNothing          = "There are no plaintext tokens to encrypt\n"
+	CredentialsMigrateRemember         = "Set the AZIONCLI_CREDENTIALS_PASSPHRASE environment variable with the passphrase to run commands without a prompt\n"
+)
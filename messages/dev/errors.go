@@ -5,4 +5,9 @@ import "errors"
 var (
 	ErrorVulcanExecute       = errors.New("Error executing Vulcan: %s")
 	ErrFailedToRunDevCommand = errors.New("Failed to run dev command. Verify if the command is correct and check the output above for more details. Run the 'azion dev' command again or contact Azion's support")
+	ErrorInvalidOrigin       = errors.New("The origin address '%s' found in azion.json is invalid. Fix the origin address and try again")
+	ErrorLoadingEnvFile      = errors.New("Failed to load the environment variables file '%s'. Verify if the file exists and is in the KEY=VALUE format and try again")
+	ErrorSyncVariables       = errors.New("Failed to fetch the edge variables of your account: %s")
+	ErrorStaticVariables     = errors.New("Static projects are served as files and don't use edge variables. Remove the '--env-file' and '--sync-variables' flags and try again")
+	ErrorServer              = errors.New("Failed to run the local development server. Verify if the host and port are available and try again. For more information, run the command again using the '--debug' flag")
 )
@@ -1,8 +1,20 @@
 package dev
 
 var (
-	DevFlagHelp         = "Displays more information about the dev command"
-	DevUsage            = "dev [flags]"
-	DevShortDescription = "Starts a local development server for the current application"
-	DevLongDescription  = "Starts a local development server for the current application, so it's possible to preview and test it locally before the deployment"
+	DevFlagHelp             = "Displays more information about the dev command"
+	DevUsage                = "dev [flags]"
+	DevShortDescription     = "Starts a local development server for the current application"
+	DevLongDescription      = "Starts a local development server for the current application, so it's possible to preview and test it locally before the deployment"
+	DevFlagPort             = "The port used by the local development server of static applications"
+	DevFlagHost             = "The host used by the local development server of static applications"
+	DevFlagPath             = "Path to where your static files are stored"
+	DevFlagWatch            = "Watches the project files and builds the edge application again every time a file changes"
+	DevFlagEnvFile          = "Path to a file with variables in the KEY=VALUE format to be injected into the local development server. Not supported by static projects"
+	DevFlagSyncVariables    = "Fetches the edge variables of your account into a local cache file before starting the local development server. Not supported by static projects"
+	DevSyncingVariables     = "Fetching edge variables from your account\n"
+	DevVariablesSynced      = "%d edge variables were saved in '%s'. Secret values aren't downloaded\n"
+	DevVariablesCacheHeader = "# Edge variables fetched by 'azion dev --sync-variables'. Do not commit this file\n"
+	DevAskSecretVariable    = "Value of the secret variable %s (leave blank to skip):"
+	DevServerRunning        = "Serving files from '%s' at http://%s\n"
+	DevServerProxy          = "Requests for files not found locally are proxied to the origin %s\n"
 )
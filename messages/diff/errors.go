@@ -0,0 +1,9 @@
+package diff
+
+import "errors"
+
+var (
+	ErrorNotDeployed = errors.New("The azion.json file doesn't have an edge application. Run 'azion deploy' first or inform a manifest with the --file flag and try again")
+	ErrorDiff        = errors.New("Failed to compare the manifest with the account: %s")
+	ErrorDrift       = errors.New("The account differs from %s in %d resources")
+)
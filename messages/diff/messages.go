@@ -0,0 +1,14 @@
+package diff
+
+var (
+	Usage            = "diff [flags]"
+	ShortDescription = "Compares a manifest with the resources of the account"
+	LongDescription  = "Compares a manifest, or the azion/azion.json file of the project, with the edge application and the resources of the account, and displays every resource that differs: + for the ones only in the manifest, ~ for the changed ones with their account and manifest values, and - for the ones only in the account. The command exits with code 9 when they differ"
+	FlagFile         = "Path of the manifest file. The azion/azion.json file of the project is compared when it's not informed"
+	FlagHelp         = "Displays more information about the diff command"
+
+	DiffHeader  = "Edge application %q:\n"
+	DiffSummary = "\n%d only in the manifest, %d changed, %d only in the account\n"
+	NoDiff      = "No differences between %s and the account\n"
+	AzionJson   = "azion.json"
+)
@@ -4,17 +4,10 @@ var (
 	// EDGE FUNCTIONS MESSAGES
 
 	//used by more than one cmd
-	EdgeFunctionFlagId      = "Unique identifier of the Edge Function"
-	EdgeFunctionFileWritten = "File successfully written to: %s\n"
-
-	//Edge Functions cmd
-	EdgeFunctionUsage            = "edge_functions <subcommand>"
-	EdgeFunctionShortDescription = "Manages your Azion account's Edge Functions"
-	EdgeFunctionLongDescription  = "Manages serverless functions on the Edge Functions library"
-	EdgeFunctionHelpFlag         = "Displays more information about the edge_functions command"
+	EdgeFunctionFlagId = "Unique identifier of the Edge Function"
 
 	//create cmd
-	EdgeFunctionCreateUsage            = "create [flags]"
+	EdgeFunctionCreateUsage            = "edge-function [flags]"
 	EdgeFunctionCreateShortDescription = "Creates a new serverless Edge Function"
 	EdgeFunctionCreateLongDescription  = "Creates an Edge Function based on given attributes to create a serverless code for Edge Applications"
 	EdgeFunctionCreateFlagName         = "The Edge Function's name"
@@ -26,29 +19,27 @@ var (
 	EdgeFunctionCreateHelpFlag         = "Displays more information about the create subcommand"
 
 	//delete cmd
-	EdgeFunctionDeleteUsage            = "delete --function-id <function_id> [flags]"
+	EdgeFunctionDeleteUsage            = "edge-function --function-id <function_id> [flags]"
 	EdgeFunctionDeleteShortDescription = "Removes an Edge Function"
 	EdgeFunctionDeleteLongDescription  = "Removes an Edge Function from the Edge Functions library based on its given ID"
 	EdgeFunctionDeleteOutputSuccess    = "Edge Function %d was successfully deleted\n"
 	EdgeFunctionDeleteHelpFlag         = "Displays more information about the delete subcommand"
 
 	//describe cmd
-	EdgeFunctionDescribeUsage            = "describe --function-id <function_id> [flags]"
+	EdgeFunctionDescribeUsage            = "edge-function --function-id <function_id> [flags]"
 	EdgeFunctionDescribeShortDescription = "Returns the Edge Function data"
 	EdgeFunctionDescribeLongDescription  = "Displays information about the Edge Function via a given ID to show the function’s attributes in detail"
-	EdgeFunctionDescribeFlagOut          = "Exports the output to the given <file_path/file_name.ext>"
-	EdgeFunctionDescribeFlagFormat       = "Changes the output format passing the json value to the flag"
 	EdgeFunctionDescribeFlagWithCode     = "Displays the Edge Function's code; disabled by default"
 	EdgeFunctionDescribeHelpFlag         = "Displays more information about the describe command"
 
 	//list cmd
-	EdgeFunctionListUsage            = "list [flags]"
+	EdgeFunctionListUsage            = "edge-function [flags]"
 	EdgeFunctionListShortDescription = "Displays your account's Edge Functions"
 	EdgeFunctionListLongDescription  = "Displays all functions in the user account’s Edge Functions library"
 	EdgeFunctionListHelpFlag         = "Displays more information about the list subcommand"
 
 	//update cmd
-	EdgeFunctionUpdateUsage            = "update --function-id <function_id> [flags]"
+	EdgeFunctionUpdateUsage            = "edge-function --function-id <function_id> [flags]"
 	EdgeFunctionUpdateShortDescription = "Modifies an Edge Function"
 	EdgeFunctionUpdateLongDescription  = "Modifies an Edge Function based on its ID to update its name, activity status, code path, and other attributes"
 	EdgeFunctionUpdateFlagName         = "The Edge Function's name"
@@ -1,30 +1,14 @@
 package edgeservices
 
 var (
-
 	// EDGE SERVICE MESSAGES
 
-	//Edge Services cmd
-	EdgeServiceUsage            = "edge_services <subcommand> [flags]"
-	EdgeServiceShortDescription = "Manages your Azion account's Edge Services"
-	EdgeServiceLongDescription  = "Manages your Edge Services of Edge Orchestrator"
-	EdgeServiceHelpFlag         = "Displays more information about the edge_services command"
-
-	//Edge Services Resources cmd
-	EdgeServiceResourceUsage            = "resources <subcommand>"
-	EdgeServiceResourceShortDescription = "Manages resources of a given Edge Service"
-	EdgeServiceResourceLongDescription  = "Manages the Edge Services´s Resources"
-	EdgeServiceResourceHelpFlag         = "Displays more information about the Resources subcommand"
-
 	//used by more than one cmd
 	EdgeServiceFlagId         = "Unique identifier of the Edge Service"
 	EdgeServiceResourceFlagId = "Unique identifier of the Resource"
-	EdgeServiceFlagOut        = "Exports the output to the given path <file_path/…/file_name.ext>"
-	EdgeServiceFlagFormat     = "Changes the output format passing the json value to the flag"
-	EdgeServiceFileWritten    = "File successfully written to: %s\n"
 
 	//create cmd
-	EdgeServiceCreateUsage            = "create [flags]"
+	EdgeServiceCreateUsage            = "edge-service [flags]"
 	EdgeServiceCreateShortDescription = "Creates a new Edge Service"
 	EdgeServiceCreateLongDescription  = "Creates a new Edge Service in the Azion Edge Orchestrator based on its name or configuration file"
 	EdgeServiceCreateFlagName         = "The Edge Service's name"
@@ -33,14 +17,14 @@ var (
 	EdgeServiceCreateFlagHelp         = "Displays more information about the create subcommand"
 
 	//delete cmd
-	EdgeServiceDeleteUsage            = "delete --service-id <service_id> [flags]"
+	EdgeServiceDeleteUsage            = "edge-service --service-id <service_id> [flags]"
 	EdgeServiceDeleteShortDescription = "Removes an Edge Service"
 	EdgeServiceDeleteLongDescription  = "Removes an Edge Service based on its given ID"
 	EdgeServiceDeleteOutputSuccess    = "Service %d was successfully deleted\n"
 	EdgeServiceDeleteFlagHelp         = "Displays more information about the delete subcommand"
 
 	//describe cmd
-	EdgeServiceDescribeUsage            = "describe --service-id <service_id> [flags]"
+	EdgeServiceDescribeUsage            = "edge-service --service-id <service_id> [flags]"
 	EdgeServiceDescribeShortDescription = "Returns the Edge Service data"
 	EdgeServiceDescribeLongDescription  = "Displays information about the Edge Service via a given ID to show the service’s attributes in detail"
 	EdgeServiceDescribeFlagWithVariable = "Displays the Edge Service's variables (disabled by default)"
@@ -48,13 +32,13 @@ var (
 	EdgeServiceDescribeHelpFlag         = "Displays more information about the describe subcommand"
 
 	//list cmd
-	EdgeServiceListUsage            = "list [flags]"
+	EdgeServiceListUsage            = "edge-service [flags]"
 	EdgeServiceListShortDescription = "Display your account’s Edge Services"
 	EdgeServiceListLongDescription  = "Displays all Edge Services in the user’s Azion account"
 	EdgeServiceListFlagHelp         = "Displays more information about the list subcommand"
 
 	//update cmd
-	EdgeServiceUpdateUsage            = "update --service-id <service_id> [flags]"
+	EdgeServiceUpdateUsage            = "edge-service --service-id <service_id> [flags]"
 	EdgeServiceUpdateShortDescription = "Modifies an Edge Service"
 	EdgeServiceUpdateLongDescription  = "Modifies the Edge Service attributes based on its ID"
 	EdgeServiceUpdateFlagName         = "The Edge Service's name"
@@ -68,7 +52,7 @@ the accepted format to define the variables is one <KEY>=<VALUE> per line`
 	//EDGE SERVICE - RESOURCES MESSAGES
 
 	//create cmd
-	EdgeServiceResourceCreateUsage            = "create --service-id <service_id> [flags]"
+	EdgeServiceResourceCreateUsage            = "edge-service-resource --service-id <service_id> [flags]"
 	EdgeServiceResourceCreateShortDescription = "Makes a new Resource"
 	EdgeServiceResourceCreateLongDescription  = "Makes a new resource in the Azion Platform based on its file’s path, name, and type"
 	EdgeServiceResourceCreateFlagName         = "The Resource's path and name; mandatory"
@@ -80,27 +64,27 @@ the accepted format to define the variables is one <KEY>=<VALUE> per line`
 	EdgeServiceResourceCreateFlagHelp         = "Displays more information about the Resources create subcommand"
 
 	//delete cmd
-	EdgeServiceResourceDeleteUsage            = "delete --service-id <service_id> --resource-id <resource_id> [flags]"
+	EdgeServiceResourceDeleteUsage            = "edge-service-resource --service-id <service_id> --resource-id <resource_id> [flags]"
 	EdgeServiceResourceDeleteShortDescription = "Removes a Resource"
 	EdgeServiceResourceDeleteLongDescription  = "Removes a Resource via given service ID and resource ID"
 	EdgeServiceResourceDeleteOutputSuccess    = "Resource %d was successfully deleted\n"
 	EdgeServiceResourceDeleteFlagHelp         = "Displays more information about the resources delete subcommand"
 
 	//describe cmd
-	EdgeServiceResourceDescribeUsage            = "describe --service-id <service_id> --resource-id <resource_id> [flags]"
+	EdgeServiceResourceDescribeUsage            = "edge-service-resource --service-id <service_id> --resource-id <resource_id> [flags]"
 	EdgeServiceResourceDescribeShortDescription = "Returns the Resource data"
 	EdgeServiceResourceDescribeLongDescription  = "Displays information about the Resource via given service ID and resource ID to show the resources’ attributes in detail"
 	EdgeServiceResourceDescribeOutputSuccess    = "Service %d was successfully deleted\n"
 	EdgeServiceResourceDescribeFlagHelp         = "Displays more information about the resources describe subcommand"
 
 	//list cmd
-	EdgeServiceResourceListUsage            = "list --service-id <service_id> [flags]"
+	EdgeServiceResourceListUsage            = "edge-service-resource --service-id <service_id> [flags]"
 	EdgeServiceResourceListShortDescription = "Display the Resources of an Edge Service"
 	EdgeServiceResourceListLongDescription  = "Displays all Resources of an Edge Service via the service ID"
 	EdgeServiceResourceListFlagHelp         = "Displays more information about the resources list subcommand"
 
 	//update cmd
-	EdgeServiceResourceUpdateUsage            = "update --service-id <service_id> --resource-id <resource_id>[flags]"
+	EdgeServiceResourceUpdateUsage            = "edge-service-resource --service-id <service_id> --resource-id <resource_id>[flags]"
 	EdgeServiceResourceUpdateShortDescription = "Modifies a Resource"
 	EdgeServiceResourceUpdateLongDescription  = "Modifies a Resource via a given service ID and resource ID to update its name, activity status, and other attributes"
 	EdgeServiceResourceUpdateFlagName         = "The resource's path and name; <PATH>/<RESOURCE_NAME>"
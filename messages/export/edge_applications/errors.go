@@ -0,0 +1,8 @@
+package edge_applications
+
+import "errors"
+
+var (
+	ErrorMissingApplicationID = errors.New("A required flag is missing. You must supply application-id as an argument. Run 'azion export edge-application --help' command to display more information and try again")
+	ErrorApplicationNotFound  = errors.New("The Edge Application %d doesn't exist. Run 'azion list edge-application' to check the ID of your Edge Application and try again")
+)
@@ -0,0 +1,13 @@
+package edge_applications
+
+var (
+	Usage            = "edge-application --application-id <application_id> [flags]"
+	ShortDescription = "Exports an Edge Application to a manifest"
+	LongDescription  = "Exports an Edge Application with its origins, cache settings, device groups, function instances, rules and domains to a manifest, with the names of the resources in the references between them. The HMAC keys of the origins are left out of the manifest, and apply keeps the keys of the account while the manifest leaves them out"
+	HelpFlag         = "Displays more information about the export edge-application command"
+
+	FlagApplicationID = "Unique identifier of the Edge Application"
+	FlagFile          = "Path of the manifest file to write, as JSON when its extension is .json and as YAML otherwise. The manifest is written to the standard output when it's not informed"
+
+	Exported = "Exported the edge application %q to %s\n"
+)
@@ -0,0 +1,8 @@
+package export
+
+var (
+	Usage            = "export"
+	ShortDescription = "Exports a resource of the account to a manifest"
+	LongDescription  = "Exports a resource of the account and what belongs to it to a manifest file that the apply command manages"
+	FlagHelp         = "Displays more information about the export command"
+)
@@ -0,0 +1,11 @@
+package general
+
+import "errors"
+
+var (
+	ErrorInvalidFormat = errors.New("The output format '%s' is invalid. Use one of table, json, yaml, csv or tsv and try again")
+	ErrorOpenOutput    = errors.New("Failed to write the output to %s: %s. Verify the path and its permissions and try again")
+	ErrorInvalidQuery  = errors.New("The query is invalid: %s. Verify the expression and try again")
+	ErrorInvalidTmpl   = errors.New("The template is invalid: %s. Verify the Go template and try again")
+	ErrorTmplConflict  = errors.New("The --template flag can't be used with --fields or --query. Use only one of them and try again")
+)
@@ -8,5 +8,13 @@ var (
 	ApiListFlagPage     = "Returns a page of the list according to its number"
 	ApiListFlagPageSize = "Defines how many items should be returned per page"
 	ApiListFlagFilter   = "Filters items by their name"
+	FlagFormat          = "Format of the output: table, json, yaml, csv or tsv. Defaults to the output_format setting, or json when --out is sent"
+	FlagOut             = "Writes the output to the given file path instead of the terminal"
+	FlagFields          = "Comma-separated fields to show, such as id,name,active. Nested fields use dots"
+	FlagQuery           = "Filters and projects the output with a GJSON path, such as '#(active==true)#.name'"
+	FlagTemplate        = "Writes each result with a Go template, such as '{{.Id}}'"
+	FileWritten         = "File successfully written to: %s\n"
+	InstallingDeps      = "Installing application dependencies with %s\n"
 	CliVersion          = "Azion CLI %s"
+	ExitCodes           = "0  success\n1  error without a code\n2  validation: invalid flags, arguments or request\n3  auth: invalid or expired token, or missing permission\n4  not found\n5  conflict: the resource changed or the name is in use\n6  rate limited by the Azion API\n7  network: the Azion API couldn't be reached\n8  internal error of the Azion API\n9  drift: the account differs from the manifest compared by 'azion diff'\n\nWith --format json, errors are written to stderr as a JSON object with code, message, status, request_id and exit_code"
 )
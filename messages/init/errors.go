@@ -22,5 +22,15 @@ var (
 	ErrorFailedCreatingAzionDirectory  = errors.New("Failed to create the azion directory. The public's parent directory is read-only and/or isn't accessible. Change the permissions of the parent directory to read and write and/or give access to it")
 	ErrorDeps                          = errors.New("Failed to install project dependencies")
 	ErrorWorkingDir                    = errors.New("Failed to change current working directory")
+	ErrorReadingTemplateSources        = errors.New("Failed to read the template sources configured in the config.yaml file of the CLI's configuration directory. Verify if the file has a valid YAML format and try again")
+	ErrorTemplateSourceNotFound        = errors.New("The template source '%s' isn't configured. Add it with 'azion config set templates.<name>.repo <repository>' and try again")
+	ErrorDirNotEmpty                   = errors.New("The directory '%s' already exists and isn't empty. Choose another name for your application and try again")
+	ErrorCloneTemplate                 = errors.New("Failed to clone the template repository %s. Verify if the URL is correct and you have access to it and try again")
+	ErrorTemplateRef                   = errors.New("The reference '%s' wasn't found as a branch or tag in the template repository %s. Verify the reference and try again")
+	ErrorTemplateSubdir                = errors.New("The directory '%s' wasn't found in the template. Verify the --subdir flag and try again")
+	ErrorTemplateManifest              = errors.New("Failed to read the template manifest azion.template.json. Verify if the file has a valid JSON format and try again")
+	ErrorTemplateOption                = errors.New("'%s' isn't a valid --%s for this template. Available options: %s")
+	ErrorTemplateCache                 = errors.New("Failed to refresh the template cache. Verify the connectivity to the template repositories and the permissions of the CLI's configuration directory and try again")
+	ErrorTemplateCacheChecksum         = errors.New("The cached copy of template %s is corrupted. Run 'azion init --refresh-templates' with network access and try again")
 	ErrorModeNotFound                  = errors.New("No mode was found for the selected template. For more information, run the command again using the '--debug' flag. If the problem persists, contact Azion’s support")
 )
@@ -25,5 +25,30 @@ var (
 	InitDevCommand                        = "If you want to start a local development server later, run 'azion dev'\n"
 	InitDeployCommand                     = "If you want to deploy your application later, run 'azion deploy'\n"
 	InitInstallDeps                       = "Installing application dependencies"
+	InitFlagTemplateRepo                  = "Git URL or local path of a custom starter template"
+	InitFlagRef                           = "Branch or tag of the custom template repository"
+	InitFlagSubdir                        = "Directory inside the custom template repository where the template is located"
+	InitFlagTemplate                      = "Name of a template source configured with the templates.<name> settings of the CLI"
+	InitFetchingTemplate                  = "Fetching template from %s\n"
+	InitChooseTemplateSource              = "Choose a template source:"
+	InitChoosePreset                      = "Choose a preset:"
+	InitChooseMode                        = "Choose a mode:"
+	InitFlagPreset                        = "The edge application's preset"
+	InitFlagMode                          = "The edge application's mode"
+	InitFlagPackageManager                = "Package manager used to install the project dependencies: npm, yarn, pnpm or bun. Detected from the project lockfile when not sent"
+	InitFlagInstallDeps                   = "Whether the project dependencies should be installed"
+	InitFlagStartDev                      = "Whether a local development server should be started after the project is initialized"
+	InitFlagDeploy                        = "Whether the project should be deployed after it is initialized"
+	InitFlagRefreshTemplates              = "Downloads the configured template sources and the available presets to the local template cache, used when there is no network access"
+	InitCachingTemplate                   = "Caching template %s from %s\n"
+	InitCachingPresets                    = "Caching the available presets and modes\n"
+	InitCachingPreset                     = "Caching a project created from preset %s\n"
+	InitTemplatesRefreshed                = "Cached %d templates and %d presets in %s\n"
+	InitUsingCachedTemplate               = "Using the cached copy of template %s, refreshed %s ago. Run 'azion init --refresh-templates' to update it\n"
+	InitUsingCachedPresets                = "Using the cached presets, refreshed %s ago. Run 'azion init --refresh-templates' to update them\n"
+	InitAskStartDev                       = "Do you want to start a local development server?"
+	InitAskDeploy                         = "Do you want to deploy your project?"
+	InitAskInstallDepsDev                 = "Do you want to install project dependencies? This may be required to start local development server"
+	InitAskInstallDepsDeploy              = "Do you want to install project dependencies? This may be required to deploy your project"
 	ModeAutomatic                         = "\nMode %s was chosen automatically, as it is the only option available for %s\n"
 )
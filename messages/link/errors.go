@@ -21,4 +21,9 @@ var (
 	ErrorFailedCreatingAzionDirectory  = errors.New("Failed to create the azion directory. The public's parent directory is read-only and/or isn't accessible. Change the permissions of the parent directory to read and write and/or give access to it")
 	ErrReadEnvFile                     = errors.New("Failed to read the webdev.env file. Verify if the file is corrupted or changed or run the 'azion edge_applications publish' command again")
 	ErrorDeps                          = errors.New("Failed to install project dependencies")
+	ErrorAdoptApplication              = errors.New("Failed to get the existing edge application: %s. Check the ID sent with --application-id and try again")
+	ErrorAdoptDomain                   = errors.New("Failed to get the existing domain: %s. Check the ID sent with --domain-id and try again")
+	ErrorAdoptFunction                 = errors.New("Failed to get the existing edge function: %s. Check the ID sent with --function-id and try again")
+	ErrorAdoptOrigin                   = errors.New("Failed to list the origins of the existing edge application: %s")
+	ErrorNoApplications                = errors.New("There are no edge applications in your account to link. Run 'azion link' without --adopt to create new ones on the next deploy")
 )
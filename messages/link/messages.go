@@ -20,5 +20,26 @@ var (
 	EdgeApplicationsLinkNameNotSentStatic = "The application name was not sent through the --name flag; By default, when --name is not given, the working directory is used\n"
 	LinkDevCommand                        = "If you want to start a local development server later, run 'azion dev'\n"
 	LinkDeployCommand                     = "If you want to deploy your application later, run 'azion deploy'\n"
-	LinkFlagAuto                          = "If sent, the entire flow of the command will be run without interruptions"
+	LinkFlagPackageManager                = "Package manager used to install the project dependencies: npm, yarn, pnpm or bun. Detected from the project lockfile when not sent"
+	LinkFlagInstallDeps                   = "Whether the project dependencies should be installed"
+	LinkFlagStartDev                      = "Whether a local development server should be started after the project is linked"
+	LinkFlagDeploy                        = "Whether the project should be deployed after it is linked"
+	LinkAskStartDev                       = "Do you want to start a local development server?"
+	LinkAskDeploy                         = "Do you want to deploy your project?"
+	LinkAskInstallDepsDev                 = "Do you want to install project dependencies? This may be required to start local development server"
+	LinkAskInstallDepsDeploy              = "Do you want to install project dependencies? This may be required to deploy the project"
+	LinkDetected                          = "Detected preset %s with %s confidence:\n"
+	LinkDetectConfidenceHigh              = "high"
+	LinkDetectConfidenceMedium            = "medium"
+	LinkDetectReasonDependency            = "package.json depends on '%s'"
+	LinkDetectReasonConfig                = "config file '%s' found"
+	LinkDetectReasonLockfile              = "dependencies are locked in '%s'"
+	LinkAskUseDetected                    = "Do you want to use the detected preset %s?"
+	LinkFlagAdopt                         = "Choose an existing edge application of your account to link, so its resources are updated by the next deploy"
+	LinkFlagApplicationID                 = "ID of an existing edge application to link. Its domain, edge function and origin are linked too"
+	LinkFlagDomainID                      = "ID of an existing domain to link, instead of the first domain that points to the edge application"
+	LinkFlagFunctionID                    = "ID of an existing edge function to link, instead of the one instanced in the edge application"
+	LinkAskApplication                    = "Choose the edge application to link:"
+	LinkAdoptedResource                   = "Linked existing %s '%s' (ID %d)\n"
+	LinkFlagAuto                          = "Accepts the detected preset and mode, names the project and skips running dev and deploy. Send --yes to link the project and override a previous configuration without asking"
 )
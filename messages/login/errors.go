@@ -0,0 +1,10 @@
+package login
+
+import "errors"
+
+var (
+	ErrorReadingToken       = errors.New("Failed to read the personal token from the standard input. Send the token through a pipe and try again. I.e. 'cat token.txt | azion login --with-token'")
+	ErrorEmptyToken         = errors.New("The personal token is empty. Send a valid personal token and try again")
+	ErrorPassphraseRequired = errors.New("Encrypting the token requires a passphrase. Set the AZIONCLI_CREDENTIALS_PASSPHRASE environment variable or run the command in an interactive terminal and try again")
+	ErrorPassphraseMismatch = errors.New("The passphrases don't match. Type the same passphrase twice and try again")
+)
@@ -0,0 +1,14 @@
+package login
+
+var (
+	LoginUsage                = "login [flags]"
+	LoginShortDescription     = "Authenticates the CLI with a personal token"
+	LoginLongDescription      = "Authenticates the CLI with a personal token, typed in a hidden prompt or read from the standard input, and saves it in the profile in use"
+	LoginFlagWithToken        = "Reads the personal token from the standard input"
+	LoginFlagHelp             = "Displays more information about the login command"
+	LoginFlagEncrypt          = "Encrypts the saved token with a passphrase read from the AZIONCLI_CREDENTIALS_PASSPHRASE environment variable or typed in a hidden prompt"
+	LoginAskToken             = "Paste your personal token:"
+	LoginAskPassphrase        = "Choose a passphrase to encrypt the token:"
+	LoginAskPassphraseConfirm = "Type the passphrase again:"
+	LoginSuccessful           = "Logged in successfully\n"
+)
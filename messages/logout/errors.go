@@ -0,0 +1,7 @@
+package logout
+
+import "errors"
+
+var (
+	ErrorRemovingToken = errors.New("Failed to remove the saved personal token: %s. Verify the permissions of the CLI's configuration directory and try again")
+)
@@ -0,0 +1,10 @@
+package logout
+
+var (
+	LogoutUsage            = "logout [flags]"
+	LogoutShortDescription = "Removes the personal token saved by the CLI"
+	LogoutLongDescription  = "Removes the personal token saved in the profile in use. Tokens sent through the AZIONCLI_TOKEN environment variable aren't affected"
+	LogoutFlagHelp         = "Displays more information about the logout command"
+	LogoutSuccessful       = "Removed the personal token saved in %s\n"
+	LogoutNotLoggedIn      = "There is no personal token saved for profile %s\n"
+)
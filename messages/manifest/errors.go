@@ -0,0 +1,14 @@
+package manifest
+
+import "errors"
+
+var (
+	ErrorReadManifest         = errors.New("Failed to read the manifest file %s: %s. Verify the path and the permissions of the file and try again")
+	ErrorParseManifest        = errors.New("Failed to parse the manifest file %s: %s. Verify the YAML or JSON syntax of the file and try again")
+	ErrorWriteManifest        = errors.New("Failed to write the manifest file %s: %s. Verify the permissions of the directory and try again")
+	ErrorMissingName          = errors.New("The manifest has an unnamed %s. Add a name to every resource of the manifest and try again")
+	ErrorDuplicatedName       = errors.New("The manifest has more than one %s named %q. Use a unique name for every resource of the same kind and try again")
+	ErrorApplicationNotFound  = errors.New("The edge application %d of the manifest doesn't exist. Remove its ID from the manifest to create it again")
+	ErrorEdgeFunctionNotFound = errors.New("The edge function %q of the function instance %q doesn't exist. Use the name or the ID of an edge function of your account and try again")
+	ErrorFetchResources       = errors.New("Failed to get the resources of the edge application: %s")
+)
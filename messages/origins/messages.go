@@ -1,31 +1,23 @@
 package origins
 
 var (
-	// [ origins ]
-	OriginsUsage            = "origins"
-	OriginsShortDescription = "Origins is the original source of data."
-	OriginsLongDescription  = "Origins is the original source of data on edge platforms, where data is fetched when cache is not available."
-	OriginsFlagHelp         = "Displays more information about the origins command"
-
 	// [ list ]
-	OriginsListUsage                 = "list [flags]"
+	OriginsListUsage                 = "origin [flags]"
 	OriginsListShortDescription      = "Displays your origins"
 	OriginsListLongDescription       = "Displays all origins related to your applications"
 	OriginsListHelpFlag              = "Displays more information about the list subcommand"
 	OriginsListFlagEdgeApplicationID = "Unique identifier for an edge application."
 
 	// [ describe ]
-	OriginsDescribeUsage             = "describe --application-id <application_id> --origin-id <origin_id> [flags]"
+	OriginsDescribeUsage             = "origin --application-id <application_id> --origin-id <origin_id> [flags]"
 	OriginsDescribeShortDescription  = "Returns information about a specific origin"
 	OriginsDescribeLongDescription   = "Returns information about a specific origin, based on a given ID, in details"
 	OriginsDescribeFlagApplicationID = "Unique identifier for an edge application. The '--application-id' flag is mandatory"
 	OriginsDescribeFlagOriginID      = "Unique identifier for an origin. The '--origin-id' flag is mandatory"
-	OriginsDescribeFlagOut           = "Exports the output to the given <file_path/file_name.ext>"
-	OriginsDescribeFlagFormat        = "Changes the output format passing the json value to the flag"
 	OriginsDescribeHelpFlag          = "Displays more information about the describe subcommand"
 
 	// [ create ]
-	OriginsCreateUsage                    = "create [flags]"
+	OriginsCreateUsage                    = "origin [flags]"
 	OriginsCreateShortDescription         = "Creates a new origin"
 	OriginsCreateLongDescription          = "Creates an origin based on given attributes to be used in edge applications"
 	OriginsCreateFlagEdgeApplicationId    = "Unique identifier for an edge application"
@@ -44,7 +36,7 @@ var (
 	OriginsCreateHelpFlag                 = "Displays more information about the create subcommand"
 
 	// [ update ]
-	OriginsUpdateUsage                    = "update [flags]"
+	OriginsUpdateUsage                    = "origin [flags]"
 	OriginsUpdateShortDescription         = "Updates an Origin"
 	OriginsUpdateLongDescription          = "Updates an Origin based on its ID and given attributes"
 	OriginsUpdateFlagOriginKey            = "The Origin's key unique identifier"
@@ -64,7 +56,7 @@ var (
 	OriginsUpdateHelpFlag                 = "Displays more information about the update subcommand"
 
 	// [ delete ]
-	OriginsDeleteUsage             = "delete [flags]"
+	OriginsDeleteUsage             = "origin [flags]"
 	OriginsDeleteShortDescription  = "Deletes an Origin"
 	OriginsDeleteLongDescription   = "Deletes an Origin from the Edge Applications library based on its given ID"
 	OriginsDeleteOutputSuccess     = "Origin %s was successfully deleted\n"
@@ -73,5 +65,4 @@ var (
 	OriginsDeleteHelpFlag          = "Displays more information about the delete subcommand"
 
 	// [ general ]
-	OriginsFileWritten = "File successfully written to: %s\n"
 )
@@ -0,0 +1,11 @@
+package profile
+
+import "errors"
+
+var (
+	ErrorProfileExists   = errors.New("The profile '%s' already exists. Remove it with 'azion profile remove' or choose another name and try again")
+	ErrorRemoveDefault   = errors.New("The default profile can't be removed")
+	ErrorInvalidDefault  = errors.New("The default setting '%s' is invalid. Use the <key>=<value> format and try again")
+	ErrorInvalidName     = errors.New("The profile name '%s' is invalid. Use only letters, numbers, '-' and '_' and try again")
+	ErrorWritingProfiles = errors.New("Failed to write the profiles.yaml file of the CLI's configuration directory. Verify the permissions of the directory and try again")
+)
@@ -0,0 +1,43 @@
+package profile
+
+var (
+	// [ profile ]
+	ProfileUsage            = "profile"
+	ProfileShortDescription = "Manages the profiles with the credentials and endpoints used by the CLI"
+	ProfileLongDescription  = "Manages named profiles, each one with its own token, API and storage URLs and default settings, to work with several Azion accounts and environments"
+	ProfileFlagHelp         = "Displays more information about the profile command"
+
+	// [ list ]
+	ProfileListUsage            = "list [flags]"
+	ProfileListShortDescription = "Displays the configured profiles"
+	ProfileListLongDescription  = "Displays the configured profiles and marks the one in use"
+	ProfileListHelpFlag         = "Displays more information about the list subcommand"
+	ProfileTokenSet             = "set"
+	ProfileTokenNotSet          = "not set"
+
+	// [ use ]
+	ProfileUseUsage            = "use <name> [flags]"
+	ProfileUseShortDescription = "Sets the profile used by default"
+	ProfileUseLongDescription  = "Sets the profile used when neither --profile nor AZIONCLI_PROFILE are sent"
+	ProfileUseHelpFlag         = "Displays more information about the use subcommand"
+	ProfileUseOutputSuccess    = "Now using profile %s\n"
+
+	// [ add ]
+	ProfileAddUsage            = "add <name> [flags]"
+	ProfileAddShortDescription = "Adds a new profile"
+	ProfileAddLongDescription  = "Adds a new profile with its token, API and storage URLs and default settings. Values that aren't sent use the CLI defaults"
+	ProfileAddHelpFlag         = "Displays more information about the add subcommand"
+	ProfileAddFlagToken        = "Personal token used by the profile"
+	ProfileAddFlagApiURL       = "Azion API URL used by the profile"
+	ProfileAddFlagStorageURL   = "Azion storage API URL used by the profile"
+	ProfileAddFlagDefault      = "Default setting of the profile in the <key>=<value> format. I.e. package_manager=pnpm. Can be sent more than once"
+	ProfileAddFlagUse          = "Sets the new profile as the one used by default"
+	ProfileAddOutputSuccess    = "Added profile %s\n"
+
+	// [ remove ]
+	ProfileRemoveUsage            = "remove <name> [flags]"
+	ProfileRemoveShortDescription = "Removes a profile"
+	ProfileRemoveLongDescription  = "Removes a profile and its credentials. When it is the profile in use, the default profile is used again"
+	ProfileRemoveHelpFlag         = "Displays more information about the remove subcommand"
+	ProfileRemoveOutputSuccess    = "Removed profile %s\n"
+)
@@ -0,0 +1,9 @@
+package root
+
+import "errors"
+
+var (
+	ErrorPassphraseRequired = errors.New("The saved token is encrypted. Set the AZIONCLI_CREDENTIALS_PASSPHRASE environment variable with its passphrase, or run the command in an interactive terminal, and try again")
+	ErrorDecryptingToken    = errors.New("Failed to decrypt the saved token. Verify the passphrase and try again")
+	ErrorEncryptingToken    = errors.New("Failed to encrypt the token: %s")
+)
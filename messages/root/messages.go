@@ -1,16 +1,24 @@
 package root
 
 var (
-	RootUsage       = "azion <command> <subcommand> [flags]"
-	RootDescription = "The Azion Command Line Interface is a unified tool to manage your Azion projects and resources"
-	RootHelpFlag    = "Displays more information about the Azion CLI"
-	RootDoNotUpdate = "Do not receive update notification"
-	RootLogDebug    = "Displays log at a debug level"
-	RootLogLevel    = "Set the logging level, \"debug\", \"info\", or \"error\". Default \"info\"."
-	RootLogSilent   = "Silences log completely; mostly used for automation purposes"
-	RootTokenFlag   = "Saves a given personal token locally to authorize CLI commands"
-	RootConfigFlag  = "Sets the Azion configuration folder for the current command only, without changing persistent settings."
-	RootYesFlag     = "Answers all yes/no interactions automatically with yes"
-	TokenSavedIn    = "Token saved in %v\n"
-	TokenUsedIn     = "This token will be used by default with all commands"
+	RootUsage             = "azion <command> <subcommand> [flags]"
+	RootDescription       = "The Azion Command Line Interface is a unified tool to manage your Azion projects and resources"
+	RootHelpFlag          = "Displays more information about the Azion CLI"
+	RootDoNotUpdate       = "Do not receive update notification"
+	RootLogDebug          = "Displays log at a debug level"
+	RootLogLevel          = "Set the logging level, \"debug\", \"info\", or \"error\". Default \"info\"."
+	RootDebugHTTPFlag     = "Logs every HTTP request and response, with tokens redacted, to stderr or to the file given as --debug-http=<path>. The AZIONCLI_HTTP_TRACE environment variable does the same"
+	RootLogFileFlag       = "Writes every log entry of the command, at debug level, as JSON to logs/azion.log in the config directory or to the file given as --log-file=<path>. The log_file setting does the same"
+	RootLogSilent         = "Silences log completely; mostly used for automation purposes"
+	RootTokenFlag         = "Saves a given personal token locally to authorize CLI commands"
+	RootConfigFlag        = "Sets the Azion configuration folder for the current command only, without changing persistent settings."
+	RootYesFlag           = "Answers all yes/no interactions automatically with yes"
+	RootNoInputFlag       = "Disables all interactive prompts; commands fail naming the flag that must be sent instead"
+	RootNoColorFlag       = "Disables colors in the output. The NO_COLOR environment variable does the same, while CLICOLOR_FORCE keeps colors when the output isn't a terminal"
+	RootProfileFlag       = "Name of the profile whose token, endpoints and defaults are used. Overrides the AZIONCLI_PROFILE environment variable and the profile in use"
+	TokenSavedIn          = "Token saved in %v\n"
+	TokenSavedInProfile   = "Token saved in profile %s\n"
+	TokenUsedIn           = "This token will be used by default with all commands"
+	RootAskPassphrase     = "Enter the passphrase of the saved token:"
+	RootWarnWorldReadable = "The credentials file %s keeps the token in plaintext and can be read by any user of this host. Run 'azion credentials migrate' to encrypt it, or restrict its permissions with 'chmod 600'"
 )
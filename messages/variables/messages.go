@@ -1,26 +1,16 @@
 package variables
 
 var (
-	// [ variables ]
-	Usage            = "variables"
-	ShortDescription = "Manages your environment variables and secrets on the Azion's platform"
-	LongDescription  = "Manages your environment variables and secrets to be used inside edge functions on the Azion's platform"
-	FlagHelp         = "Displays more information about the variables command"
-	FlagVariableID   = "Unique identifier for a variable. The '--variable-id' flag is mandatory"
+	FlagVariableID = "Unique identifier for a variable. The '--variable-id' flag is mandatory"
 
 	// [ describe ]
-	DescribeUsage            = "describe --variable-id <variable_id> [flags]"
+	DescribeUsage            = "variable --variable-id <variable_id> [flags]"
 	DescribeShortDescription = "Returns the specific variable's key and value"
 	DescribeLongDescription  = "Displays information about a variable based on a given UUID to show the variable's attributes in detail"
-	DescribeFlagOut          = "Exports the output to the given filepath, such as: <file_path/file_name.ext>"
-	DescribeFlagFormat       = "Changes the output format passing the json value to the flag"
 	DescribeHelpFlag         = "Displays more information about the describe subcommand"
 
-	// [ general ]
-	FileWritten = "File successfully written to: %s\n"
-
 	// [ list ]
-	VariablesListUsage            = "list [flags]"
+	VariablesListUsage            = "variable [flags]"
 	VariablesListShortDescription = "Displays your variables in a list"
 	VariablesListLongDescription  = "Displays all your environment variables and secrets in a list"
 	VariablesListHelpFlag         = "Displays more information about the list subcommand"
@@ -28,12 +18,12 @@ var (
 	// [ delete ]
 	DeleteOutputSuccess    = "Variable %v was successfully deleted\n"
 	DeleteHelpFlag         = "Displays more information about the delete subcommand"
-	DeleteUsage            = "delete [flags]"
+	DeleteUsage            = "variable [flags]"
 	DeleteShortDescription = "Deletes a variable"
 	DeleteLongDescription  = "Deletes a variable based on its UUID"
 
 	//update cmd
-	UpdateUsage            = "update --variable-id <variable_id> [flags]"
+	UpdateUsage            = "variable --variable-id <variable_id> [flags]"
 	UpdateShortDescription = "Modifies a variable's attributes"
 	UpdateLongDescription  = "Modifies a variable's attributes based on its UUID"
 	UpdateFlagKey          = "The variable's key"
@@ -44,7 +34,7 @@ var (
 	UpdateHelpFlag         = "Displays more information about the update subcommand"
 
 	// [ create ]
-	CreateUsage            = "create [flags]"
+	CreateUsage            = "variable [flags]"
 	CreateShortDescription = "Creates a new environment variable or secret on the Azion's platform"
 	CreateLongDescription  = "Creates a new environment variable or secret to be used inside edge functions on the Azion's platform"
 	CreateFlagKey          = "Informs the variable's key"
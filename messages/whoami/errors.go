@@ -0,0 +1,8 @@
+package whoami
+
+import "errors"
+
+var (
+	ErrorNotLoggedIn = errors.New("There is no personal token in use. Run 'azion login' to authenticate and try again")
+	ErrorGetUser     = errors.New("Failed to get the user of the personal token: %s. Verify your connectivity and try again")
+)
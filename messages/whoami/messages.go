@@ -0,0 +1,18 @@
+package whoami
+
+var (
+	WhoamiUsage            = "whoami [flags]"
+	WhoamiShortDescription = "Displays the account and user of the personal token in use"
+	WhoamiLongDescription  = "Displays the account and user that own the personal token in use, the profile and where the token came from"
+	WhoamiFlagHelp         = "Displays more information about the whoami command"
+	WhoamiName             = "Name: %s\n"
+	WhoamiEmail            = "Email: %s\n"
+	WhoamiAccount          = "Account: %s\n"
+	WhoamiClientID         = "Client ID: %s\n"
+	WhoamiProfile          = "Profile: %s\n"
+	WhoamiTokenSource      = "Token source: %s\n"
+	WhoamiSourceFlag       = "--token flag"
+	WhoamiSourceEnv        = "AZIONCLI_TOKEN environment variable"
+	WhoamiSourceProfile    = "profile %s in the profiles.yaml file"
+	WhoamiSourceFile       = "file %s"
+)
@@ -0,0 +1,231 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// MaxRetries is how many times a request is sent again after a 429, 502, 503 or 504 response.
+// POST and PATCH requests may have been applied by the API on the other responses, so they are
+// sent again only after a 429 or 503 response with a Retry-After header
+const MaxRetries = 3
+
+// maxRetryAfter limits how long a Retry-After header makes the CLI wait
+const maxRetryAfter = time.Minute
+
+// BackoffBase is the wait before the first retry when the response has no Retry-After header.
+// It doubles on each retry
+var BackoffBase = 500 * time.Millisecond
+
+// RevealToken returns the token a request sends from the token the client was created with.
+// Saved tokens may be encrypted, and the root command sets it to decrypt them only when a
+// request needs the token
+var RevealToken = func(token string) (string, error) {
+	return token, nil
+}
+
+// UserAgent identifies the CLI in the requests to the Azion APIs
+func UserAgent() string {
+	return "Azion_CLI/" + version.BinVersion
+}
+
+// New returns a copy of c for the API packages. Its requests carry the token and the user agent
+// of the CLI and are retried with backoff on 429, 502, 503 and 504 responses. The timeout of c
+// applies to each attempt instead of the whole call, and c itself is never changed
+func New(c *http.Client, token string) *http.Client {
+	return NewWithScheme(c, "token", token)
+}
+
+// NewWithScheme returns the client of New for the APIs that expect the token with another
+// authorization scheme
+func NewWithScheme(c *http.Client, scheme, token string) *http.Client {
+	if c == nil {
+		c = &http.Client{}
+	}
+
+	base := c.Transport
+	if t, ok := base.(*Transport); ok {
+		base = t.Base
+	}
+
+	clone := *c
+	clone.Timeout = 0
+	clone.Transport = &Transport{
+		Base:    base,
+		Scheme:  scheme,
+		Token:   token,
+		Timeout: c.Timeout,
+	}
+	return &clone
+}
+
+// Transport adds the headers of the CLI to the requests and retries the ones that hit rate limits
+// or unavailable gateways
+type Transport struct {
+	Base http.RoundTripper
+	// Scheme is sent before the token in the Authorization header, token when empty
+	Scheme  string
+	Token   string
+	Timeout time.Duration
+}
+
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	scheme := t.Scheme
+	if scheme == "" {
+		scheme = "token"
+	}
+
+	token := t.Token
+	if token != "" {
+		var err error
+		if token, err = RevealToken(token); err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		ctx, cancel := req.Context(), context.CancelFunc(func() {})
+		if t.Timeout > 0 {
+			ctx, cancel = context.WithTimeout(req.Context(), t.Timeout)
+		}
+
+		r := req.Clone(ctx)
+		if token != "" {
+			r.Header.Set("Authorization", scheme+" "+token)
+		}
+		r.Header.Set("User-Agent", UserAgent())
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			r.Body = body
+		}
+
+		resp, err := base.RoundTrip(r)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		if !retryable(req, resp) || attempt >= MaxRetries || (req.Body != nil && req.GetBody == nil) {
+			resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := retryAfter(resp, attempt)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		cancel()
+
+		logger.Debug("Retrying request", zap.String("url", req.URL.String()), zap.Int("status", resp.StatusCode), zap.Duration("wait", wait))
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func retryable(req *http.Request, resp *http.Response) bool {
+	switch req.Method {
+	case http.MethodPost, http.MethodPatch:
+		// the API rejected these requests without applying them, as it tells when to send them again
+		return (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) &&
+			resp.Header.Get("Retry-After") != ""
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the wait asked by the Retry-After header, in seconds or as a date,
+// or an exponential backoff when there is none
+func retryAfter(resp *http.Response, attempt int) time.Duration {
+	wait := BackoffBase << attempt
+
+	header := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		wait = time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+	}
+
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+	return wait
+}
+
+// cancelBody releases the timeout of an attempt once its response is read
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// NewTransport returns the transport used to reach the Azion APIs. It uses the proxy URL when set,
+// or the HTTPS_PROXY and NO_PROXY environment variables, and trusts the CA bundle file when set
+func NewTransport(proxy, caBundle string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxy != "" {
+		u, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	if caBundle != "" {
+		pem, err := os.ReadFile(caBundle)
+		if err != nil {
+			return nil, err
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in " + caBundle)
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return transport, nil
+}
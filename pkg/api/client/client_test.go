@@ -0,0 +1,143 @@
+package client
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestNew(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	BackoffBase = time.Millisecond
+
+	t.Run("adds the headers of the CLI", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_applications"),
+			httpmock.StatusStringResponse(http.StatusOK, "{}"),
+		)
+
+		shared := &http.Client{Transport: mock, Timeout: 10 * time.Second}
+		resp, err := New(shared, "123").Get("https://api.azion.net/edge_applications")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+
+		require.Equal(t, "token 123", mock.Requests[0].Header.Get("Authorization"))
+		require.Equal(t, UserAgent(), mock.Requests[0].Header.Get("User-Agent"))
+		// the shared client is never changed
+		require.Equal(t, 10*time.Second, shared.Timeout)
+		require.Equal(t, mock, shared.Transport)
+	})
+
+	t.Run("retries unavailable responses", func(t *testing.T) {
+		statuses := []int{http.StatusTooManyRequests, http.StatusServiceUnavailable, http.StatusOK}
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			status := statuses[0]
+			statuses = statuses[1:]
+			resp, err := httpmock.StatusStringResponse(status, "{}")(req)
+			resp.Header = http.Header{"Retry-After": []string{"0"}}
+			return resp, err
+		})
+
+		resp, err := New(&http.Client{Transport: transport}, "123").Get("https://api.azion.net/domains")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Empty(t, statuses)
+	})
+
+	t.Run("gives up after the last retry", func(t *testing.T) {
+		attempts := 0
+		transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			return httpmock.StatusStringResponse(http.StatusBadGateway, "{}")(req)
+		})
+
+		resp, err := New(&http.Client{Transport: transport}, "123").Get("https://api.azion.net/domains")
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		require.Equal(t, MaxRetries+1, attempts)
+	})
+
+	t.Run("sends the token with the scheme", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("POST", "storage/123"),
+			httpmock.StatusStringResponse(http.StatusOK, "{}"),
+		)
+
+		resp, err := NewWithScheme(&http.Client{Transport: mock}, "Token", "123").Post("https://api.azion.net/storage/123", "application/json", strings.NewReader("{}"))
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		require.Equal(t, "Token 123", mock.Requests[0].Header.Get("Authorization"))
+	})
+
+	t.Run("retries post only when asked to", func(t *testing.T) {
+		tests := []struct {
+			name       string
+			status     int
+			retryAfter string
+			attempts   int
+		}{
+			{name: "bad gateway", status: http.StatusBadGateway, attempts: 1},
+			{name: "gateway timeout with retry-after", status: http.StatusGatewayTimeout, retryAfter: "0", attempts: 1},
+			{name: "unavailable without retry-after", status: http.StatusServiceUnavailable, attempts: 1},
+			{name: "rate limited with retry-after", status: http.StatusTooManyRequests, retryAfter: "0", attempts: MaxRetries + 1},
+			{name: "unavailable with retry-after", status: http.StatusServiceUnavailable, retryAfter: "0", attempts: MaxRetries + 1},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				attempts := 0
+				transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					attempts++
+					resp, err := httpmock.StatusStringResponse(tt.status, "{}")(req)
+					if tt.retryAfter != "" {
+						resp.Header = http.Header{"Retry-After": []string{tt.retryAfter}}
+					}
+					return resp, err
+				})
+
+				resp, err := New(&http.Client{Transport: transport}, "123").Post("https://api.azion.net/domains", "application/json", strings.NewReader("{}"))
+				require.NoError(t, err)
+				require.NoError(t, resp.Body.Close())
+				require.Equal(t, tt.status, resp.StatusCode)
+				require.Equal(t, tt.attempts, attempts)
+			})
+		}
+	})
+}
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestNewTransport(t *testing.T) {
+	t.Run("proxy", func(t *testing.T) {
+		transport, err := NewTransport("http://proxy.local:3128", "")
+		require.NoError(t, err)
+
+		req, _ := http.NewRequest("GET", "https://api.azion.net", nil)
+		proxy, err := transport.Proxy(req)
+		require.NoError(t, err)
+		require.Equal(t, "proxy.local:3128", proxy.Host)
+	})
+
+	t.Run("invalid CA bundle", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0600))
+
+		_, err := NewTransport("", path)
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// MaxTraceBody is how many bytes of each body are written to a trace
+const MaxTraceBody = 4096
+
+const redacted = "[REDACTED]"
+
+// secretHeaders are redacted in traces and debug logs
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+}
+
+// secretFields matches the JSON fields of bodies whose values are redacted
+var secretFields = regexp.MustCompile(`(?i)("(?:[^"]*(?:token|password|secret|passphrase|api_key)[^"]*|key)"\s*:\s*)"[^"]*"`)
+
+// RedactHeaders returns a copy of the headers without credentials
+func RedactHeaders(h http.Header) http.Header {
+	clean := h.Clone()
+	for name := range clean {
+		if secretHeaders[http.CanonicalHeaderKey(name)] {
+			clean.Set(name, redacted)
+		}
+	}
+	return clean
+}
+
+// RedactBody returns the body without the values of secret JSON fields, cut at MaxTraceBody bytes
+// without splitting a character
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if !utf8.Valid(body) {
+		return fmt.Sprintf("[binary body, %d bytes]", len(body))
+	}
+
+	text := secretFields.ReplaceAllString(string(body), `$1"`+redacted+`"`)
+	if len(text) > MaxTraceBody {
+		// cut before the rune that crosses the limit, so the trace stays valid UTF-8
+		cut := MaxTraceBody
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+		return fmt.Sprintf("%s... [%d bytes truncated]", text[:cut], len(text)-cut)
+	}
+	return text
+}
+
+// TraceTransport writes every request and response that goes through it to Out, with
+// credentials redacted and bodies truncated, so the trace can be shared in support tickets
+type TraceTransport struct {
+	Base http.RoundTripper
+	Out  io.Writer
+	mu   sync.Mutex
+}
+
+func (t *TraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	var reqBody []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		req = req.Clone(req.Context())
+		reqBody, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+	}
+
+	var entry bytes.Buffer
+	fmt.Fprintf(&entry, "> %s %s\n", req.Method, req.URL.String())
+	writeHeaders(&entry, "> ", req.Header)
+	writeBody(&entry, "> ", reqBody)
+
+	start := time.Now()
+	resp, err := base.RoundTrip(req)
+	latency := time.Since(start).Round(time.Millisecond)
+	if err != nil {
+		fmt.Fprintf(&entry, "< error after %s: %s\n\n", latency, err)
+		t.write(entry.Bytes())
+		return nil, err
+	}
+
+	respBody, readErr := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	fmt.Fprintf(&entry, "< %d %s (%s)\n", resp.StatusCode, http.StatusText(resp.StatusCode), latency)
+	writeHeaders(&entry, "< ", resp.Header)
+	writeBody(&entry, "< ", respBody)
+	entry.WriteString("\n")
+	t.write(entry.Bytes())
+
+	if readErr != nil {
+		return nil, readErr
+	}
+	return resp, nil
+}
+
+// write keeps the entries of concurrent requests apart
+func (t *TraceTransport) write(entry []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = t.Out.Write(entry)
+}
+
+func writeHeaders(w io.Writer, prefix string, h http.Header) {
+	clean := RedactHeaders(h)
+	names := make([]string, 0, len(clean))
+	for name := range clean {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "%s%s: %s\n", prefix, name, strings.Join(clean[name], ", "))
+	}
+}
+
+func writeBody(w io.Writer, prefix string, body []byte) {
+	text := RedactBody(body)
+	if text == "" {
+		return
+	}
+	fmt.Fprintf(w, "%s\n", prefix)
+	for _, line := range strings.Split(text, "\n") {
+		fmt.Fprintf(w, "%s%s\n", prefix, line)
+	}
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceTransport(t *testing.T) {
+	mock := &httpmock.Registry{}
+	mock.Register(
+		httpmock.REST("POST", "personal_tokens"),
+		httpmock.StatusStringResponse(http.StatusCreated, `{"results": {"name": "ci", "key": "azion1234", "token": "azionsecret"}}`),
+	)
+
+	out := &bytes.Buffer{}
+	c := &http.Client{Transport: &TraceTransport{Base: mock, Out: out}}
+
+	req, err := http.NewRequest("POST", "https://api.azion.net/personal_tokens", strings.NewReader(`{"name": "ci", "password": "hunter2"}`))
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "token azionb43a9554776")
+
+	resp, err := c.Do(req)
+	require.NoError(t, err)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "azionsecret")
+
+	trace := out.String()
+	require.Contains(t, trace, "> POST https://api.azion.net/personal_tokens")
+	require.Contains(t, trace, "< 201")
+	require.Contains(t, trace, "Authorization: [REDACTED]")
+	require.Contains(t, trace, `"password": "[REDACTED]"`)
+	require.Contains(t, trace, `"token": "[REDACTED]"`)
+	require.NotContains(t, trace, "azionb43a9554776")
+	require.NotContains(t, trace, "hunter2")
+	require.NotContains(t, trace, "azionsecret")
+	require.NotContains(t, trace, "azion1234")
+}
+
+func TestRedactBody(t *testing.T) {
+	long := strings.Repeat("a", MaxTraceBody+10)
+	require.Contains(t, RedactBody([]byte(long)), "[10 bytes truncated]")
+	require.Equal(t, "[binary body, 2 bytes]", RedactBody([]byte{0xff, 0xfe}))
+
+	// "€" crosses the limit, so it is cut whole along with the rest
+	multibyte := strings.Repeat("a", MaxTraceBody-1) + "€" + "é"
+	text := RedactBody([]byte(multibyte))
+	require.True(t, utf8.ValidString(text))
+	require.Equal(t, strings.Repeat("a", MaxTraceBody-1)+"... [5 bytes truncated]", text)
+}
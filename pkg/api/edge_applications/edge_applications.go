@@ -503,10 +503,10 @@ func (c *Client) UpdateRulesEngine(ctx context.Context, req *UpdateRulesEngineRe
 				return nil, err
 			}
 		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
 	}
 
 	return &edgeApplicationsResponse.Results, nil
-
 }
 
 func (c *Client) CreateRulesEngine(ctx context.Context, edgeApplicationID int64, phase string, req *CreateRulesEngineRequest) (RulesEngineResponse, error) {
@@ -522,6 +522,7 @@ func (c *Client) CreateRulesEngine(ctx context.Context, edgeApplicationID int64,
 				return nil, err
 			}
 		}
+		return nil, utils.ErrorPerStatusCode(httpResp, err)
 	}
 	return &resp.Results, nil
 }
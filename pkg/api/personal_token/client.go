@@ -2,9 +2,8 @@ package personal_token
 
 import (
 	"net/http"
-	"time"
 
-	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	"github.com/aziontech/azion-cli/pkg/api/client"
 	sdk "github.com/aziontech/azionapi-go-sdk/personal_tokens"
 )
 
@@ -14,14 +13,12 @@ type Client struct {
 
 func NewClient(c *http.Client, url string, token string) *Client {
 	conf := sdk.NewConfiguration()
-	conf.HTTPClient = c
-	conf.AddDefaultHeader("Authorization", "token "+token)
+	conf.HTTPClient = client.New(c, token)
 	conf.AddDefaultHeader("Accept", "application/json;version=3")
-	conf.UserAgent = "Azion_CLI/" + version.BinVersion
+	conf.UserAgent = client.UserAgent()
 	conf.Servers = sdk.ServerConfigurations{
 		{URL: url},
 	}
-	conf.HTTPClient.Timeout = 30 * time.Second
 
 	return &Client{
 		apiClient: sdk.NewAPIClient(conf),
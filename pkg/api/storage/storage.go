@@ -4,7 +4,7 @@ import (
 	"context"
 	"net/http"
 
-	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	"github.com/aziontech/azion-cli/pkg/api/client"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"go.uber.org/zap"
@@ -19,8 +19,8 @@ type Client struct {
 
 func NewClient(c *http.Client, url string, token string) *Client {
 	conf := sdk.NewConfiguration()
-	conf.AddDefaultHeader("Authorization", "Token "+token)
-	conf.UserAgent = "Azion_CLI/" + version.BinVersion
+	conf.HTTPClient = client.NewWithScheme(c, "Token", token)
+	conf.UserAgent = client.UserAgent()
 	conf.Servers = sdk.ServerConfigurations{
 		{URL: url},
 	}
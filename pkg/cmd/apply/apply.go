@@ -0,0 +1,161 @@
+package apply
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/apply"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/iostreams"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/manifest"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+type ApplyCmd struct {
+	Io           *iostreams.IOStreams
+	F            *cmdutil.Factory
+	ReadManifest func(path string) (*manifest.Manifest, error)
+	AskConfirm   func(msg string) (bool, error)
+}
+
+func NewApplyCmd(f *cmdutil.Factory) *ApplyCmd {
+	return &ApplyCmd{
+		Io:           f.IOStreams,
+		F:            f,
+		ReadManifest: manifest.Read,
+		AskConfirm:   askConfirm,
+	}
+}
+
+func NewCobraCmd(apply *ApplyCmd) *cobra.Command {
+	var file string
+	var autoApprove bool
+
+	cobraCmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ShortDescription,
+		Long:          msg.LongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion apply --file stack.yaml
+		$ azion apply -f stack.json --auto-approve
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed("file") {
+				return msg.ErrorMissingFile
+			}
+			return apply.Run(file, autoApprove)
+		},
+	}
+
+	cobraCmd.Flags().StringVarP(&file, "file", "f", "", msg.FlagFile)
+	cobraCmd.Flags().BoolVar(&autoApprove, "auto-approve", false, msg.FlagAutoApprove)
+	cobraCmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cobraCmd
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	return NewCobraCmd(NewApplyCmd(f))
+}
+
+func (cmd *ApplyCmd) Run(path string, autoApprove bool) error {
+	logger.Debug("Running apply command")
+
+	desired, err := cmd.ReadManifest(path)
+	if err != nil {
+		return utils.NewError(utils.CodeValidation, err)
+	}
+	recorded, err := desired.Marshal(manifest.IsJSON(path))
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	clients := manifest.NewClients(cmd.F.HttpClient, cmd.F.Config.GetString("api_url"), cmd.F.Config.GetString("token"))
+	appID, err := clients.FindApplication(ctx, &desired.EdgeApplication)
+	if err != nil {
+		return utils.WrapError(msg.ErrorApply, err)
+	}
+	var live *manifest.Manifest
+	if appID != 0 {
+		live, err = clients.Fetch(ctx, appID)
+		if err != nil {
+			return err
+		}
+	}
+
+	plan := manifest.NewPlan(desired, live)
+	if plan.Empty() {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.NoChanges, desired.EdgeApplication.Name))
+		return cmd.recordIDs(path, desired, recorded)
+	}
+
+	fmt.Fprintf(cmd.Io.Out, msg.PlanHeader, desired.EdgeApplication.Name)
+	plan.Print(cmd.Io.Out)
+	create, update, del := plan.Count()
+	fmt.Fprintf(cmd.Io.Out, msg.PlanSummary, create, update, del)
+
+	approved, err := cmd.confirm(autoApprove)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		logger.FInfo(cmd.Io.Out, msg.Cancelled)
+		return nil
+	}
+
+	a := &applier{ctx: ctx, clients: clients, out: cmd.Io.Out, desired: desired}
+	err = a.apply(plan)
+	// the IDs of what was created are recorded even when a later change fails, so running
+	// the command again doesn't create the resources twice
+	if recordErr := cmd.recordIDs(path, desired, recorded); recordErr != nil && err == nil {
+		err = recordErr
+	}
+	if err != nil {
+		logger.Debug("Error while applying the manifest", zap.Error(err))
+		return utils.WrapError(msg.ErrorApply, err)
+	}
+
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.Applied, a.created, a.updated, a.deleted))
+	return nil
+}
+
+// confirm asks for the approval of the plan unless --auto-approve or --yes was sent
+func (cmd *ApplyCmd) confirm(autoApprove bool) (bool, error) {
+	if autoApprove || cmd.F.GlobalFlagAll {
+		return true, nil
+	}
+	if !cmd.F.CanPrompt() {
+		return false, utils.NewError(utils.CodeValidation, msg.ErrorAutoApproveRequired)
+	}
+	return cmd.AskConfirm(msg.AskConfirm)
+}
+
+// recordIDs saves the manifest when the IDs of its resources changed
+func (cmd *ApplyCmd) recordIDs(path string, m *manifest.Manifest, recorded []byte) error {
+	data, err := m.Marshal(manifest.IsJSON(path))
+	if err != nil || bytes.Equal(data, recorded) {
+		return err
+	}
+	if err := m.Write(path); err != nil {
+		return err
+	}
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.IDsRecorded, path))
+	return nil
+}
+
+func askConfirm(msg string) (bool, error) {
+	var confirmed bool
+	err := survey.AskOne(&survey.Confirm{Message: msg}, &confirmed)
+	if err != nil {
+		return false, err
+	}
+	return confirmed, nil
+}
@@ -0,0 +1,181 @@
+package apply
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/manifest"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func registerLive(mock *httpmock.Registry) {
+	testutils.RegisterEdgeApplication(mock, nil)
+	mock.Register(httpmock.REST("GET", "domains"), httpmock.JSONFromFile("./fixtures/domains.json"))
+}
+
+func copyStack(t *testing.T) string {
+	data, err := os.ReadFile("./fixtures/stack.yaml")
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "stack.yaml")
+	require.NoError(t, os.WriteFile(path, data, 0644))
+	return path
+}
+
+const plan = `Edge application "my-app":
+  + origin "api"
+  ~ cache setting "Default Cache Settings" (10)
+      browser_cache_settings_maximum_ttl: 0 -> 3600
+  ~ request rule "Default Rule" (100)
+      behaviors: [{"name":"set_origin","target":"Default Origin"}] -> [{"name":"set_origin","target":"api"}]
+  - response rule "legacy" (200)
+
+Plan: 1 to create, 2 to update, 1 to delete
+`
+
+func TestApply(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	color.NoColor = true
+
+	t.Run("apply the plan", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		rule, err := os.ReadFile("./fixtures/rule.json")
+		require.NoError(t, err)
+		mock.Register(httpmock.REST("POST", "edge_applications/1234/origins"), httpmock.JSONFromFile("./fixtures/origin.json"))
+		mock.Register(httpmock.REST("PATCH", "edge_applications/1234/cache_settings/10"), httpmock.JSONFromFile("./fixtures/cache_setting.json"))
+		mock.Register(
+			httpmock.REST("PATCH", "edge_applications/1234/rules_engine/request/rules/100"),
+			httpmock.WithHeader(httpmock.RESTPayload(200, string(rule), func(payload map[string]interface{}) {
+				// the rule targets the ID of the origin created before it
+				require.Equal(t, []interface{}{map[string]interface{}{"name": "set_origin", "target": "2"}}, payload["behaviors"])
+			}), "Content-Type", "application/json"),
+		)
+		mock.Register(httpmock.REST("DELETE", "edge_applications/1234/rules_engine/response/rules/200"), httpmock.StatusStringResponse(204, ""))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		path := copyStack(t)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--file", path, "--auto-approve"})
+
+		_, err = cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+		require.Equal(t, plan+`Created the origin "api"
+Updated the cache setting "Default Cache Settings"
+Updated the request rule "Default Rule"
+Deleted the response rule "legacy"
+The IDs of the resources were recorded in `+path+`
+Applied: 1 created, 2 updated, 1 deleted
+`, stdout.String())
+
+		recorded, err := manifest.Read(path)
+		require.NoError(t, err)
+		app := recorded.EdgeApplication
+		require.Equal(t, "0cee30cd-1743-4202-b0dd-da9b636a6035", app.Origins[0].Key)
+		require.Equal(t, int64(2), app.Origins[1].ID)
+		require.Equal(t, "e4f0761b-d2ac-4168-aa4b-f525d08396fd", app.Origins[1].Key)
+		require.Equal(t, int64(10), app.CacheSettings[0].ID)
+		require.Equal(t, int64(100), app.RulesEngine.Request[0].ID)
+		require.Equal(t, "api", app.RulesEngine.Request[0].Behaviors[0].Target)
+	})
+
+	t.Run("create the edge application", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "edge_applications"), testutils.EdgeApplicationFixture("empty.json"))
+		mock.Register(httpmock.REST("POST", "edge_applications"), testutils.EdgeApplicationFixture("application.json"))
+		mock.Register(httpmock.REST("PATCH", "edge_applications/1234"), testutils.EdgeApplicationFixture("application.json"))
+		// the live resources are the default origin, cache setting and rule the API created with the application
+		registerLive(mock)
+		mock.Register(httpmock.REST("POST", "edge_applications/1234/origins"), httpmock.JSONFromFile("./fixtures/origin.json"))
+		mock.Register(httpmock.REST("PATCH", "edge_applications/1234/cache_settings/10"), httpmock.JSONFromFile("./fixtures/cache_setting.json"))
+		mock.Register(httpmock.REST("PATCH", "edge_applications/1234/rules_engine/request/rules/100"), httpmock.JSONFromFile("./fixtures/rule.json"))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		path := copyStack(t)
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, []byte(strings.Replace(string(data), "  id: 1234\n", "", 1)), 0644))
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--file", path, "--auto-approve"})
+
+		_, err = cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+		// the response rule the manifest leaves out wasn't in the approved plan, so it is kept
+		for _, req := range mock.Requests {
+			require.NotEqual(t, "DELETE", req.Method, req.URL.Path)
+		}
+		require.Equal(t, `Edge application "my-app":
+  + edge application "my-app"
+  + origin "Default Origin"
+  + origin "api"
+  + cache setting "Default Cache Settings"
+  + request rule "Default Rule"
+
+Plan: 5 to create, 0 to update, 0 to delete
+Created the edge application "my-app"
+Created the origin "api"
+Updated the cache setting "Default Cache Settings"
+Updated the request rule "Default Rule"
+The IDs of the resources were recorded in `+path+`
+Applied: 2 created, 2 updated, 0 deleted
+`, stdout.String())
+
+		recorded, err := manifest.Read(path)
+		require.NoError(t, err)
+		app := recorded.EdgeApplication
+		require.Equal(t, int64(1234), app.ID)
+		require.Equal(t, int64(1), app.Origins[0].ID)
+		require.Equal(t, int64(2), app.Origins[1].ID)
+		require.Equal(t, int64(10), app.CacheSettings[0].ID)
+		require.Equal(t, int64(100), app.RulesEngine.Request[0].ID)
+	})
+
+	t.Run("approval required", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		path := copyStack(t)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--file", path})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "Making the changes without a prompt requires approval. Review the plan and run the command again with the --auto-approve flag")
+		require.Equal(t, plan, stdout.String())
+	})
+
+	t.Run("changes declined", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		f.IOStreams.SetStdinTTY(true)
+		path := copyStack(t)
+		applyCmd := NewApplyCmd(f)
+		applyCmd.AskConfirm = func(string) (bool, error) { return false, nil }
+		cmd := NewCobraCmd(applyCmd)
+		cmd.SetArgs([]string{"--file", path})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Equal(t, plan+"No changes were made\n", stdout.String())
+	})
+
+	t.Run("missing file flag", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "A required flag is missing. Inform the path of the manifest with the --file flag and try again")
+	})
+}
@@ -0,0 +1,476 @@
+package apply
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	msg "github.com/aziontech/azion-cli/messages/apply"
+	msgManifest "github.com/aziontech/azion-cli/messages/manifest"
+	"github.com/aziontech/azion-cli/pkg/api/domains"
+	edgeApplications "github.com/aziontech/azion-cli/pkg/api/edge_applications"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/manifest"
+	sdkDomains "github.com/aziontech/azionapi-go-sdk/domains"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
+)
+
+// applier makes the changes of a plan, recording in the manifest the IDs of the resources it
+// creates and replacing the names the rules refer to with the IDs of the resources
+type applier struct {
+	ctx     context.Context
+	clients *manifest.Clients
+	out     io.Writer
+	desired *manifest.Manifest
+
+	created, updated, deleted int
+}
+
+func (a *applier) apply(plan *manifest.Plan) error {
+	for _, c := range plan.Changes {
+		if err := a.change(c); err != nil {
+			return err
+		}
+		if c.Kind != manifest.KindApplication || c.Action != manifest.ActionCreate {
+			continue
+		}
+
+		// the API creates a default origin, cache setting and rule with the edge application,
+		// so the rest of the plan is made again to use them. Resources the manifest doesn't
+		// list weren't in the approved plan, so they aren't deleted
+		live, err := a.clients.Fetch(a.ctx, a.desired.EdgeApplication.ID)
+		if err != nil {
+			return err
+		}
+		for _, c := range manifest.NewPlan(a.desired, live).Changes {
+			if c.Action == manifest.ActionDelete {
+				continue
+			}
+			if err := a.change(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func (a *applier) change(c manifest.Change) error {
+	var err error
+	switch c.Kind {
+	case manifest.KindApplication:
+		err = a.application(c)
+	case manifest.KindDeviceGroup:
+		err = a.deviceGroup(c)
+	case manifest.KindOrigin:
+		err = a.origin(c)
+	case manifest.KindCacheSetting:
+		err = a.cacheSetting(c)
+	case manifest.KindFunctionInstance:
+		err = a.functionInstance(c)
+	case manifest.KindRule:
+		err = a.rule(c)
+	case manifest.KindDomain:
+		err = a.domain(c)
+	}
+
+	kind := c.Kind
+	if c.Phase != "" {
+		kind = c.Phase + " " + kind
+	}
+	if err != nil {
+		return fmt.Errorf(msg.ErrorChange.Error(), c.Action, kind, c.Name, err)
+	}
+
+	switch c.Action {
+	case manifest.ActionCreate:
+		a.created++
+		logger.FInfo(a.out, fmt.Sprintf(msg.Created, kind, c.Name))
+	case manifest.ActionUpdate:
+		a.updated++
+		logger.FInfo(a.out, fmt.Sprintf(msg.Updated, kind, c.Name))
+	case manifest.ActionDelete:
+		a.deleted++
+		logger.FInfo(a.out, fmt.Sprintf(msg.Deleted, kind, c.Name))
+	}
+	return nil
+}
+
+func (a *applier) appID() int64 {
+	return a.desired.EdgeApplication.ID
+}
+
+func (a *applier) application(c manifest.Change) error {
+	app := c.Desired.(*manifest.Application)
+	if c.Action == manifest.ActionCreate {
+		req := &edgeApplications.CreateRequest{}
+		req.SetName(app.Name)
+		if app.DeliveryProtocol != "" {
+			req.SetDeliveryProtocol(app.DeliveryProtocol)
+		}
+		resp, err := a.clients.Applications.Create(a.ctx, req)
+		if err != nil {
+			return err
+		}
+		app.ID = resp.GetId()
+	}
+
+	req := &edgeApplications.UpdateRequest{Id: app.ID}
+	req.SetName(app.Name)
+	if app.DeliveryProtocol != "" {
+		req.SetDeliveryProtocol(app.DeliveryProtocol)
+	}
+	if app.MinimumTlsVersion != "" {
+		req.SetMinimumTlsVersion(app.MinimumTlsVersion)
+	}
+	req.Active = app.Active
+	req.ApplicationAcceleration = app.ApplicationAcceleration
+	req.Caching = app.Caching
+	req.DeviceDetection = app.DeviceDetection
+	req.EdgeFirewall = app.EdgeFirewall
+	req.EdgeFunctions = app.EdgeFunctions
+	req.ImageOptimization = app.ImageOptimization
+	req.L2Caching = app.L2Caching
+	req.LoadBalancer = app.LoadBalancer
+	req.RawLogs = app.RawLogs
+	req.WebApplicationFirewall = app.WebApplicationFirewall
+	_, err := a.clients.Applications.Update(a.ctx, req)
+	return err
+}
+
+func (a *applier) deviceGroup(c manifest.Change) error {
+	switch c.Action {
+	case manifest.ActionCreate:
+		g := c.Desired.(*manifest.DeviceGroup)
+		req := &edgeApplications.CreateDeviceGroupsRequest{}
+		req.SetName(g.Name)
+		req.SetUserAgent(g.UserAgent)
+		resp, err := a.clients.Applications.CreateDeviceGroups(a.ctx, req, a.appID())
+		if err != nil {
+			return err
+		}
+		g.ID = resp.GetId()
+		return nil
+	case manifest.ActionUpdate:
+		g := c.Desired.(*manifest.DeviceGroup)
+		req := sdk.PatchDeviceGroupsRequest{}
+		req.SetName(g.Name)
+		if g.UserAgent != "" {
+			req.SetUserAgent(g.UserAgent)
+		}
+		_, err := a.clients.Applications.UpdateDeviceGroup(a.ctx, req, a.appID(), g.ID)
+		return err
+	default:
+		g := c.Live.(*manifest.DeviceGroup)
+		return a.clients.Applications.DeleteDeviceGroup(a.ctx, a.appID(), g.ID)
+	}
+}
+
+func (a *applier) origin(c manifest.Change) error {
+	switch c.Action {
+	case manifest.ActionCreate:
+		o := c.Desired.(*manifest.Origin)
+		req := &edgeApplications.CreateOriginsRequest{}
+		req.SetName(o.Name)
+		req.SetHostHeader(o.HostHeader)
+		req.SetAddresses(addresses(o.Addresses))
+		if o.OriginType != "" {
+			req.SetOriginType(o.OriginType)
+		}
+		if o.OriginProtocolPolicy != "" {
+			req.SetOriginProtocolPolicy(o.OriginProtocolPolicy)
+		}
+		if o.OriginPath != "" {
+			req.SetOriginPath(o.OriginPath)
+		}
+		req.HmacAuthentication = o.HmacAuthentication
+		req.HmacRegionName = stringPtr(o.HmacRegionName)
+		req.HmacAccessKey = stringPtr(o.HmacAccessKey)
+		req.HmacSecretKey = stringPtr(o.HmacSecretKey)
+		resp, err := a.clients.Applications.CreateOrigins(a.ctx, a.appID(), req)
+		if err != nil {
+			return err
+		}
+		o.ID, o.Key = resp.GetOriginId(), resp.GetOriginKey()
+		return nil
+	case manifest.ActionUpdate:
+		o := c.Desired.(*manifest.Origin)
+		req := &edgeApplications.UpdateOriginsRequest{}
+		req.SetName(o.Name)
+		if o.Addresses != nil {
+			req.SetAddresses(addresses(o.Addresses))
+		}
+		req.OriginType = stringPtr(o.OriginType)
+		req.OriginProtocolPolicy = stringPtr(o.OriginProtocolPolicy)
+		req.HostHeader = stringPtr(o.HostHeader)
+		req.OriginPath = stringPtr(o.OriginPath)
+		req.HmacAuthentication = o.HmacAuthentication
+		req.HmacRegionName = stringPtr(o.HmacRegionName)
+		req.HmacAccessKey = stringPtr(o.HmacAccessKey)
+		req.HmacSecretKey = stringPtr(o.HmacSecretKey)
+		_, err := a.clients.Applications.UpdateOrigins(a.ctx, a.appID(), o.Key, req)
+		return err
+	default:
+		o := c.Live.(*manifest.Origin)
+		return a.clients.Applications.DeleteOrigins(a.ctx, a.appID(), o.Key)
+	}
+}
+
+func (a *applier) cacheSetting(c manifest.Change) error {
+	switch c.Action {
+	case manifest.ActionCreate:
+		cs := c.Desired.(*manifest.CacheSetting)
+		req := &edgeApplications.CreateCacheSettingsRequest{}
+		req.SetName(cs.Name)
+		req.BrowserCacheSettings = stringPtr(cs.BrowserCacheSettings)
+		req.BrowserCacheSettingsMaximumTtl = cs.BrowserCacheSettingsMaximumTtl
+		req.CdnCacheSettings = stringPtr(cs.CdnCacheSettings)
+		req.CdnCacheSettingsMaximumTtl = cs.CdnCacheSettingsMaximumTtl
+		req.CacheByQueryString = stringPtr(cs.CacheByQueryString)
+		req.QueryStringFields = cs.QueryStringFields
+		req.EnableQueryStringSort = cs.EnableQueryStringSort
+		req.CacheByCookies = stringPtr(cs.CacheByCookies)
+		req.CookieNames = cs.CookieNames
+		req.AdaptiveDeliveryAction = stringPtr(cs.AdaptiveDeliveryAction)
+		req.EnableCachingForPost = cs.EnableCachingForPost
+		req.EnableCachingForOptions = cs.EnableCachingForOptions
+		req.L2CachingEnabled = cs.L2CachingEnabled
+		resp, err := a.clients.Applications.CreateCacheSettings(a.ctx, req, a.appID())
+		if err != nil {
+			return err
+		}
+		cs.ID = resp.GetId()
+		return nil
+	case manifest.ActionUpdate:
+		cs := c.Desired.(*manifest.CacheSetting)
+		req := &edgeApplications.UpdateCacheSettingsRequest{Id: cs.ID}
+		req.SetName(cs.Name)
+		req.BrowserCacheSettings = stringPtr(cs.BrowserCacheSettings)
+		req.BrowserCacheSettingsMaximumTtl = cs.BrowserCacheSettingsMaximumTtl
+		req.CdnCacheSettings = stringPtr(cs.CdnCacheSettings)
+		req.CdnCacheSettingsMaximumTtl = cs.CdnCacheSettingsMaximumTtl
+		req.CacheByQueryString = stringPtr(cs.CacheByQueryString)
+		req.QueryStringFields = cs.QueryStringFields
+		req.EnableQueryStringSort = cs.EnableQueryStringSort
+		req.CacheByCookies = stringPtr(cs.CacheByCookies)
+		req.CookieNames = cs.CookieNames
+		req.AdaptiveDeliveryAction = stringPtr(cs.AdaptiveDeliveryAction)
+		req.EnableCachingForPost = cs.EnableCachingForPost
+		req.EnableCachingForOptions = cs.EnableCachingForOptions
+		req.L2CachingEnabled = cs.L2CachingEnabled
+		_, err := a.clients.Applications.UpdateCacheSettings(a.ctx, req, a.appID())
+		return err
+	default:
+		cs := c.Live.(*manifest.CacheSetting)
+		return a.clients.Applications.DeleteCacheSettings(a.ctx, a.appID(), cs.ID)
+	}
+}
+
+func (a *applier) functionInstance(c manifest.Change) error {
+	if c.Action == manifest.ActionDelete {
+		fi := c.Live.(*manifest.FunctionInstance)
+		return a.clients.Applications.DeleteFunctionInstance(a.ctx, strconv.FormatInt(a.appID(), 10), strconv.FormatInt(fi.ID, 10))
+	}
+
+	fi := c.Desired.(*manifest.FunctionInstance)
+	args := fi.Args
+	if args == nil {
+		args = map[string]interface{}{}
+	}
+	functionID, err := a.edgeFunctionID(fi)
+	if err != nil {
+		return err
+	}
+
+	if c.Action == manifest.ActionCreate {
+		req := &edgeApplications.CreateFuncInstancesRequest{}
+		req.SetName(fi.Name)
+		req.SetEdgeFunctionId(functionID)
+		req.SetArgs(args)
+		resp, err := a.clients.Applications.CreateFuncInstances(a.ctx, req, a.appID())
+		if err != nil {
+			return err
+		}
+		fi.ID = resp.GetId()
+		return nil
+	}
+
+	req := &edgeApplications.UpdateInstanceRequest{}
+	req.SetName(fi.Name)
+	req.SetEdgeFunctionId(functionID)
+	req.SetArgs(args)
+	_, err = a.clients.Applications.UpdateInstance(a.ctx, req, strconv.FormatInt(a.appID(), 10), strconv.FormatInt(fi.ID, 10))
+	return err
+}
+
+// edgeFunctionID returns the ID of the edge function a function instance runs, which the
+// manifest refers to by name or by ID
+func (a *applier) edgeFunctionID(fi *manifest.FunctionInstance) (int64, error) {
+	names, err := a.clients.EdgeFunctionNames(a.ctx)
+	if err != nil {
+		return 0, err
+	}
+	for id, name := range names {
+		if name == fi.EdgeFunction {
+			return id, nil
+		}
+	}
+	if id, err := strconv.ParseInt(fi.EdgeFunction, 10, 64); err == nil {
+		return id, nil
+	}
+	return 0, fmt.Errorf(msgManifest.ErrorEdgeFunctionNotFound.Error(), fi.EdgeFunction, fi.Name)
+}
+
+func (a *applier) rule(c manifest.Change) error {
+	if c.Action == manifest.ActionDelete {
+		r := c.Live.(*manifest.Rule)
+		return a.clients.Applications.DeleteRulesEngine(a.ctx, a.appID(), c.Phase, r.ID)
+	}
+
+	r := c.Desired.(*manifest.Rule)
+	criteria := make([][]sdk.RulesEngineCriteria, 0, len(r.Criteria))
+	for _, group := range r.Criteria {
+		entries := make([]sdk.RulesEngineCriteria, 0, len(group))
+		for _, cr := range group {
+			entries = append(entries, sdk.RulesEngineCriteria{
+				Conditional: cr.Conditional,
+				Variable:    cr.Variable,
+				Operator:    cr.Operator,
+				InputValue:  stringPtr(cr.InputValue),
+			})
+		}
+		criteria = append(criteria, entries)
+	}
+	behaviors := make([]sdk.RulesEngineBehaviorEntry, 0, len(r.Behaviors))
+	for _, b := range r.Behaviors {
+		if b.Capture != nil {
+			behaviors = append(behaviors, sdk.RulesEngineBehaviorEntry{
+				RulesEngineBehaviorObject: &sdk.RulesEngineBehaviorObject{
+					Name: b.Name,
+					Target: sdk.RulesEngineBehaviorObjectTarget{
+						CapturedArray: stringPtr(b.Capture.CapturedArray),
+						Subject:       stringPtr(b.Capture.Subject),
+						Regex:         stringPtr(b.Capture.Regex),
+					},
+				},
+			})
+			continue
+		}
+		behaviors = append(behaviors, sdk.RulesEngineBehaviorEntry{
+			RulesEngineBehaviorString: &sdk.RulesEngineBehaviorString{Name: b.Name, Target: a.target(b)},
+		})
+	}
+
+	if c.Action == manifest.ActionCreate {
+		req := &edgeApplications.CreateRulesEngineRequest{}
+		req.SetName(r.Name)
+		req.Description = stringPtr(r.Description)
+		req.SetCriteria(criteria)
+		req.SetBehaviors(behaviors)
+		resp, err := a.clients.Applications.CreateRulesEngine(a.ctx, a.appID(), c.Phase, req)
+		if err != nil {
+			return err
+		}
+		r.ID = resp.GetId()
+		return nil
+	}
+
+	req := &edgeApplications.UpdateRulesEngineRequest{IdApplication: a.appID(), Phase: c.Phase, Id: r.ID}
+	req.SetName(r.Name)
+	req.Description = stringPtr(r.Description)
+	if r.Criteria != nil {
+		req.SetCriteria(criteria)
+	}
+	if r.Behaviors != nil {
+		req.SetBehaviors(behaviors)
+	}
+	_, err := a.clients.Applications.UpdateRulesEngine(a.ctx, req)
+	return err
+}
+
+// target returns the target of a behavior, with the ID of the origin, cache setting or
+// function instance in place of the name the manifest refers to it by
+func (a *applier) target(b manifest.Behavior) string {
+	app := &a.desired.EdgeApplication
+	switch b.Name {
+	case manifest.BehaviorSetOrigin:
+		for _, o := range app.Origins {
+			if o.Name == b.Target {
+				return strconv.FormatInt(o.ID, 10)
+			}
+		}
+	case manifest.BehaviorSetCachePolicy:
+		for _, cs := range app.CacheSettings {
+			if cs.Name == b.Target {
+				return strconv.FormatInt(cs.ID, 10)
+			}
+		}
+	case manifest.BehaviorRunFunction:
+		for _, fi := range app.FunctionInstances {
+			if fi.Name == b.Target {
+				return strconv.FormatInt(fi.ID, 10)
+			}
+		}
+	}
+	return b.Target
+}
+
+func (a *applier) domain(c manifest.Change) error {
+	switch c.Action {
+	case manifest.ActionCreate:
+		d := c.Desired.(*manifest.Domain)
+		req := &domains.CreateRequest{}
+		req.SetName(d.Name)
+		req.SetCnames(d.Cnames)
+		if req.Cnames == nil {
+			req.SetCnames([]string{})
+		}
+		if d.CnameAccessOnly != nil {
+			req.SetCnameAccessOnly(*d.CnameAccessOnly)
+		}
+		req.SetIsActive(d.IsActive == nil || *d.IsActive)
+		req.SetEdgeApplicationId(a.appID())
+		if d.DigitalCertificateID != nil {
+			req.SetDigitalCertificateId(*d.DigitalCertificateID)
+		}
+		resp, err := a.clients.Domains.Create(a.ctx, req)
+		if err != nil {
+			return err
+		}
+		d.ID = resp.GetId()
+		return nil
+	case manifest.ActionUpdate:
+		d := c.Desired.(*manifest.Domain)
+		req := &domains.UpdateRequest{Id: d.ID}
+		req.SetName(d.Name)
+		req.Cnames = d.Cnames
+		req.CnameAccessOnly = d.CnameAccessOnly
+		req.IsActive = d.IsActive
+		req.SetEdgeApplicationId(a.appID())
+		if d.DigitalCertificateID != nil {
+			req.DigitalCertificateId = *sdkDomains.NewNullableInt64(d.DigitalCertificateID)
+		}
+		_, err := a.clients.Domains.Update(a.ctx, req)
+		return err
+	default:
+		d := c.Live.(*manifest.Domain)
+		return a.clients.Domains.Delete(a.ctx, d.ID)
+	}
+}
+
+func addresses(list []string) []sdk.CreateOriginsRequestAddresses {
+	addresses := make([]sdk.CreateOriginsRequestAddresses, 0, len(list))
+	for _, address := range list {
+		addresses = append(addresses, sdk.CreateOriginsRequestAddresses{Address: address})
+	}
+	return addresses
+}
+
+// stringPtr returns nil for empty strings, which the manifest uses for the fields it leaves out
+func stringPtr(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
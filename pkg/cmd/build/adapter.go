@@ -9,13 +9,13 @@ import (
 	"go.uber.org/zap"
 )
 
-func adapter(cmd *BuildCmd, conf *contracts.AzionApplicationOptions) error {
+func adapter(cmd *BuildCmd, conf *contracts.AzionApplicationOptions, envs []string) error {
 	const command = "npx --yes azion-framework-adapter@0.4.0 build --version-id %s"
 
 	// pre-build version id. Used to check if there were changes to the project
 	versionID := cmd.VersionID()
 
-	err := runCommand(cmd, fmt.Sprintf(command, versionID))
+	err := runCommand(cmd, fmt.Sprintf(command, versionID), envs)
 	if err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"io"
 	"io/fs"
 	"os"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/build"
@@ -17,12 +18,15 @@ import (
 
 var Preset string
 var Mode string
+var EnvFile string
+var Watch bool
 
 type BuildCmd struct {
 	Io                    *iostreams.IOStreams
 	WriteFile             func(filename string, data []byte, perm fs.FileMode) error
 	CommandRunnerStream   func(out io.Writer, cmd string, envvars []string) error
-	CommandRunInteractive func(f *cmdutil.Factory, comm string) error
+	CommandRunInteractive func(f *cmdutil.Factory, comm string, envVars []string) error
+	CommandRunner         func(cmd string, envvars []string) (string, int, error)
 	FileReader            func(path string) ([]byte, error)
 	ConfigRelativePath    string
 	GetAzionJsonContent   func() (*contracts.AzionApplicationOptions, error)
@@ -30,6 +34,8 @@ type BuildCmd struct {
 	EnvLoader             func(path string) ([]string, error)
 	Stat                  func(path string) (fs.FileInfo, error)
 	VersionID             func() string
+	NewWatcher            func() (FileWatcher, error)
+	WatchDebounce         time.Duration
 	f                     *cmdutil.Factory
 }
 
@@ -44,8 +50,15 @@ func NewCobraCmd(build *BuildCmd) *cobra.Command {
 		Long:          msg.BuildLongDescription,
 		SilenceErrors: true,
 		SilenceUsage:  true,
-		Example:       heredoc.Doc("\n$ azion build\n"),
+		Example: heredoc.Doc(`
+		$ azion build
+		$ azion build --env-file ./azion/build.env
+		$ azion build --watch
+		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if Watch {
+				return build.watch()
+			}
 			return build.run()
 		},
 	}
@@ -53,6 +66,8 @@ func NewCobraCmd(build *BuildCmd) *cobra.Command {
 	buildCmd.Flags().BoolP("help", "h", false, msg.BuildFlagHelp)
 	buildCmd.Flags().StringVar(&Preset, "preset", "", msg.FlagTemplate)
 	buildCmd.Flags().StringVar(&Mode, "mode", "", msg.FlagMode)
+	buildCmd.Flags().StringVar(&EnvFile, "env-file", "", msg.FlagEnvFile)
+	buildCmd.Flags().BoolVar(&Watch, "watch", false, msg.FlagWatch)
 
 	return buildCmd
 }
@@ -64,8 +79,11 @@ func NewBuildCmd(f *cmdutil.Factory) *BuildCmd {
 		CommandRunnerStream: func(out io.Writer, cmd string, envs []string) error {
 			return utils.RunCommandStreamOutput(f.IOStreams.Out, envs, cmd)
 		},
-		CommandRunInteractive: func(f *cmdutil.Factory, comm string) error {
-			return utils.CommandRunInteractive(f, comm)
+		CommandRunInteractive: func(f *cmdutil.Factory, comm string, envVars []string) error {
+			return utils.CommandRunInteractiveWithEnv(f, comm, envVars)
+		},
+		CommandRunner: func(cmd string, envvars []string) (string, int, error) {
+			return utils.RunCommandWithOutput(envvars, cmd)
 		},
 		ConfigRelativePath:    "/azion/config.json",
 		EnvLoader:             utils.LoadEnvVarsFromFile,
@@ -75,6 +93,8 @@ func NewBuildCmd(f *cmdutil.Factory) *BuildCmd {
 		Stat:                  os.Stat,
 		f:                     f,
 		VersionID:             createVersionID,
+		NewWatcher:            newFileWatcher,
+		WatchDebounce:         watchDebounce,
 	}
 }
 
@@ -0,0 +1,46 @@
+package build
+
+import (
+	msg "github.com/aziontech/azion-cli/messages/build"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"go.uber.org/zap"
+)
+
+// custom runs the build command informed in the build section of azion.json
+func custom(cmd *BuildCmd, conf *contracts.AzionApplicationOptions, envs []string) error {
+	logger.FInfo(cmd.Io.Out, msg.BuildCustomCmd)
+
+	switch conf.Build.OutputCtrl {
+	case "", "disable":
+		err := runCommand(cmd, conf.Build.Cmd, envs)
+		if err != nil {
+			return err
+		}
+	case "on-error":
+		logger.FInfo(cmd.Io.Out, msg.BuildStart)
+		logger.Debug("Build environment variables", zap.Strings("envs", utils.RedactEnvVars(envs)))
+
+		output, exitCode, err := cmd.CommandRunner(conf.Build.Cmd, envs)
+		if err != nil {
+			logger.FInfo(cmd.Io.Out, output)
+			logger.Debug("Error while running build command", zap.Int("exit code", exitCode), zap.Error(err))
+			return msg.ErrFailedToRunBuildCommand
+		}
+
+		logger.FInfo(cmd.Io.Out, msg.BuildSuccessful)
+	default:
+		return msg.EdgeApplicationsOutputErr
+	}
+
+	conf.VersionID = cmd.VersionID()
+
+	err := cmd.WriteAzionJsonContent(conf)
+	if err != nil {
+		logger.Debug("Error while writing azion.json file", zap.Error(err))
+		return utils.ErrorWritingAzionJsonFile
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package build
+
+import (
+	"errors"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/build"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCustom(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	envs := []string{"API_URL=https://conf.example.com", "API_URL=https://flag.example.com"}
+
+	tests := []struct {
+		name       string
+		outputCtrl string
+		runErr     error
+		output     string
+		err        error
+		stdout     string
+	}{
+		{
+			name:   "runs the command with the env vars",
+			stdout: msg.BuildCustomCmd + msg.BuildStart + msg.BuildRunningCmd + "$ npm run build\n" + msg.BuildSuccessful,
+		},
+		{
+			name:       "runs the command showing the output on error",
+			outputCtrl: "on-error",
+			output:     "built",
+			stdout:     msg.BuildCustomCmd + msg.BuildStart + msg.BuildSuccessful,
+		},
+		{
+			name:   "command fails",
+			runErr: errors.New("exit status 1"),
+			err:    msg.ErrFailedToRunBuildCommand,
+		},
+		{
+			name:       "command fails showing the output",
+			outputCtrl: "on-error",
+			runErr:     errors.New("exit status 1"),
+			output:     "src/index.js: syntax error",
+			err:        msg.ErrFailedToRunBuildCommand,
+			stdout:     msg.BuildCustomCmd + msg.BuildStart + "src/index.js: syntax error",
+		},
+		{
+			name:       "unknown output-ctrl",
+			outputCtrl: "always",
+			err:        msg.EdgeApplicationsOutputErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, stdout, _ := testutils.NewFactory(nil)
+			var ran []string
+			var written *contracts.AzionApplicationOptions
+
+			cmd := NewBuildCmd(f)
+			cmd.CommandRunInteractive = func(f *cmdutil.Factory, comm string, envVars []string) error {
+				require.Equal(t, envs, envVars)
+				ran = append(ran, comm)
+				return tt.runErr
+			}
+			cmd.CommandRunner = func(comm string, envVars []string) (string, int, error) {
+				require.Equal(t, envs, envVars)
+				ran = append(ran, comm)
+				if tt.runErr != nil {
+					return tt.output, 1, tt.runErr
+				}
+				return tt.output, 0, nil
+			}
+			cmd.VersionID = func() string { return "123" }
+			cmd.WriteAzionJsonContent = func(conf *contracts.AzionApplicationOptions) error {
+				written = conf
+				return nil
+			}
+
+			conf := &contracts.AzionApplicationOptions{}
+			conf.Build.Cmd = "npm run build"
+			conf.Build.OutputCtrl = tt.outputCtrl
+
+			err := custom(cmd, conf, envs)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, written)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []string{"npm run build"}, ran)
+				require.Equal(t, "123", written.VersionID)
+			}
+			if tt.stdout != "" {
+				require.Equal(t, tt.stdout, stdout.String())
+			}
+		})
+	}
+}
@@ -43,6 +43,15 @@ func RunBuildCmdLine(cmd *BuildCmd) error {
 		return err
 	}
 
+	envs, err := loadEnvVars(cmd, conf)
+	if err != nil {
+		return err
+	}
+
+	if conf.Build.Cmd != "" {
+		return custom(cmd, conf, envs)
+	}
+
 	if conf.Template == "simple" {
 		logger.FInfo(cmd.Io.Out, msg.BuildSimple)
 		return nil
@@ -61,11 +70,11 @@ func RunBuildCmdLine(cmd *BuildCmd) error {
 	}
 
 	if conf.Template != "nextjs" {
-		return vulcan(cmd, conf)
+		return vulcan(cmd, conf, envs)
 	}
 
 	if conf.Template == "nextjs" {
-		return adapter(cmd, conf)
+		return adapter(cmd, conf, envs)
 	}
 
 	return utils.ErrorUnsupportedType
@@ -4,17 +4,20 @@ import (
 	"fmt"
 
 	msg "github.com/aziontech/azion-cli/messages/build"
+	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
 	"go.uber.org/zap"
 )
 
-func runCommand(cmd *BuildCmd, command string) error {
+func runCommand(cmd *BuildCmd, command string, envs []string) error {
 	logger.FInfo(cmd.Io.Out, msg.BuildStart)
 
 	logger.FInfo(cmd.Io.Out, msg.BuildRunningCmd)
 	logger.FInfo(cmd.Io.Out, fmt.Sprintf("$ %s\n", command))
+	logger.Debug("Build environment variables", zap.Strings("envs", utils.RedactEnvVars(envs)))
 
-	err := cmd.CommandRunInteractive(cmd.f, command)
+	err := cmd.CommandRunInteractive(cmd.f, command, envs)
 	if err != nil {
 		logger.Debug("Error while running command with simultaneous output", zap.Error(err))
 		return msg.ErrFailedToRunBuildCommand
@@ -23,3 +26,24 @@ func runCommand(cmd *BuildCmd, command string) error {
 	logger.FInfo(cmd.Io.Out, msg.BuildSuccessful)
 	return nil
 }
+
+// loadEnvVars loads the variables from the env file set in azion.json build configuration
+// and from the --env-file flag. Variables from the flag take precedence
+func loadEnvVars(cmd *BuildCmd, conf *contracts.AzionApplicationOptions) ([]string, error) {
+	var envs []string
+
+	for _, path := range []string{conf.Build.Env, EnvFile} {
+		if path == "" {
+			continue
+		}
+
+		fileEnvs, err := cmd.EnvLoader(path)
+		if err != nil {
+			logger.Debug("Error while loading environment variables file", zap.Error(err))
+			return nil, fmt.Errorf(msg.ErrorLoadingEnvFile.Error(), path)
+		}
+		envs = append(envs, fileEnvs...)
+	}
+
+	return envs, nil
+}
@@ -0,0 +1,82 @@
+package build
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/build"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLoadEnvVars(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	dir := t.TempDir()
+	confEnv := filepath.Join(dir, "build.env")
+	flagEnv := filepath.Join(dir, "flag.env")
+	malformed := filepath.Join(dir, "malformed.env")
+	_ = os.WriteFile(confEnv, []byte("API_URL=https://conf.example.com\nMODE=production\n"), 0644)
+	_ = os.WriteFile(flagEnv, []byte("# overrides the build env of azion.json\nAPI_URL=https://flag.example.com\n"), 0644)
+	_ = os.WriteFile(malformed, []byte("API_URL\n"), 0644)
+
+	tests := []struct {
+		name     string
+		confEnv  string
+		envFile  string
+		expected []string
+		err      string
+	}{
+		{
+			name: "no env files",
+		},
+		{
+			name:     "build env of azion.json",
+			confEnv:  confEnv,
+			expected: []string{"API_URL=https://conf.example.com", "MODE=production"},
+		},
+		{
+			// the variables of the flag come last, so they take precedence over the ones of azion.json
+			name:     "env file flag after the build env",
+			confEnv:  confEnv,
+			envFile:  flagEnv,
+			expected: []string{"API_URL=https://conf.example.com", "MODE=production", "API_URL=https://flag.example.com"},
+		},
+		{
+			name:    "missing env file",
+			confEnv: confEnv,
+			envFile: filepath.Join(dir, "missing.env"),
+			err:     fmt.Sprintf(msg.ErrorLoadingEnvFile.Error(), filepath.Join(dir, "missing.env")),
+		},
+		{
+			name:    "malformed env file",
+			confEnv: malformed,
+			err:     fmt.Sprintf(msg.ErrorLoadingEnvFile.Error(), malformed),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			EnvFile = tt.envFile
+			defer func() { EnvFile = "" }()
+
+			f, _, _ := testutils.NewFactory(nil)
+			cmd := NewBuildCmd(f)
+			conf := &contracts.AzionApplicationOptions{}
+			conf.Build.Env = tt.confEnv
+
+			envs, err := loadEnvVars(cmd, conf)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, envs)
+		})
+	}
+}
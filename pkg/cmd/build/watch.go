@@ -0,0 +1,249 @@
+package build
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/build"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/zap"
+)
+
+const watchDebounce = 300 * time.Millisecond
+
+// directories that are never watched, wherever they are in the project tree. The dependencies are
+// skipped even when .gitignore doesn't list them, as they are too many to watch and change on installs
+var watchIgnoredDirs = []string{".git", "node_modules"}
+
+// files rewritten by every build, as patterns anchored to the project root
+var watchBuildFiles = []string{"/azion/azion.json", "/azion/args.json"}
+
+// files with gitignore-like patterns of paths that should not trigger a rebuild
+var watchIgnoreFiles = []string{".gitignore", ".azionignore"}
+
+// FileWatcher notifies changes to files and directories. It is implemented by fsnotify in
+// production and may be replaced by fake events in tests
+type FileWatcher interface {
+	Add(name string) error
+	Close() error
+	Events() <-chan fsnotify.Event
+	Errors() <-chan error
+}
+
+type fsWatcher struct {
+	*fsnotify.Watcher
+}
+
+func (w *fsWatcher) Events() <-chan fsnotify.Event {
+	return w.Watcher.Events
+}
+
+func (w *fsWatcher) Errors() <-chan error {
+	return w.Watcher.Errors
+}
+
+func newFileWatcher() (FileWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	return &fsWatcher{watcher}, nil
+}
+
+// watch builds the project once and keeps rebuilding it on changes until the user interrupts it
+func (cmd *BuildCmd) watch() error {
+	start := time.Now()
+	err := RunBuildCmdLine(cmd)
+	if err != nil {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.BuildWatchFailed, time.Since(start).Round(time.Millisecond), err.Error()))
+	}
+
+	ctx, stop := utils.NotifyInterrupt(context.Background())
+	defer stop()
+	return cmd.RunWatch(ctx, ".")
+}
+
+// RunWatch watches the project tree and runs the build again every time a file changes,
+// until ctx is done. Build errors are reported and do not stop the watcher
+func (cmd *BuildCmd) RunWatch(ctx context.Context, root string) error {
+	watcher, err := cmd.NewWatcher()
+	if err != nil {
+		logger.Debug("Error while creating file watcher", zap.Error(err))
+		return msg.ErrorWatch
+	}
+	defer watcher.Close()
+
+	patterns := append(cmd.buildOutputs(), cmd.readIgnorePatterns(root)...)
+
+	if err := cmd.addWatchDirs(watcher, root, root, patterns); err != nil {
+		logger.Debug("Error while adding directories to file watcher", zap.Error(err))
+		return msg.ErrorWatch
+	}
+
+	logger.FInfo(cmd.Io.Out, msg.BuildWatching)
+
+	var timer *time.Timer
+	var rebuild <-chan time.Time
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events():
+			if !ok {
+				return nil
+			}
+			if event.Op == fsnotify.Chmod || isIgnored(root, event.Name, patterns) {
+				continue
+			}
+			logger.Debug("File change detected", zap.String("event", event.String()))
+
+			if event.Op&fsnotify.Create == fsnotify.Create {
+				if info, err := cmd.Stat(event.Name); err == nil && info.IsDir() {
+					if err := cmd.addWatchDirs(watcher, root, event.Name, patterns); err != nil {
+						logger.Debug("Error while watching new directory", zap.Error(err))
+					}
+				}
+			}
+
+			// wait for the changes to settle before building again
+			if timer != nil {
+				timer.Stop()
+			}
+			timer = time.NewTimer(cmd.WatchDebounce)
+			rebuild = timer.C
+		case err, ok := <-watcher.Errors():
+			if !ok {
+				return nil
+			}
+			logger.Debug("Error while watching files", zap.Error(err))
+		case <-rebuild:
+			rebuild = nil
+			cmd.rebuild()
+		}
+	}
+}
+
+func (cmd *BuildCmd) rebuild() {
+	logger.FInfo(cmd.Io.Out, msg.BuildWatchRebuilding)
+
+	start := time.Now()
+	err := RunBuildCmdLine(cmd)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	if err != nil {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.BuildWatchFailed, elapsed, err.Error()))
+		return
+	}
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.BuildWatchRebuilt, elapsed))
+}
+
+func (cmd *BuildCmd) addWatchDirs(watcher FileWatcher, root, dir string, patterns []string) error {
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != root && isIgnored(root, path, patterns) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(path)
+	})
+}
+
+// buildOutputs returns the paths written by the build of the project preset, as patterns anchored
+// to the project root. The output of custom build commands is ignored through .gitignore or .azionignore
+func (cmd *BuildCmd) buildOutputs() []string {
+	outputs := append([]string{}, watchBuildFiles...)
+
+	conf, err := cmd.GetAzionJsonContent()
+	if err != nil {
+		logger.Debug("Error while reading azion.json to find the build output", zap.Error(err))
+		return outputs
+	}
+	if Preset != "" {
+		conf.Template = Preset
+	}
+
+	switch {
+	case conf.Build.Cmd != "", conf.Template == "static", conf.Template == "simple":
+		return outputs
+	case conf.Template == "nextjs":
+		return append(outputs, "/.vercel", "/out")
+	default:
+		return append(outputs, "/.edge")
+	}
+}
+
+func (cmd *BuildCmd) readIgnorePatterns(root string) []string {
+	var patterns []string
+	for _, name := range watchIgnoreFiles {
+		data, err := cmd.FileReader(filepath.Join(root, name))
+		if err != nil {
+			continue
+		}
+
+		scanner := bufio.NewScanner(strings.NewReader(string(data)))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			// negations are not supported, so they are skipped along with comments
+			if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+				continue
+			}
+			// like in .gitignore, a pattern with a slash other than a trailing one is anchored to the root
+			line = strings.TrimSuffix(line, "/")
+			if strings.Contains(line, "/") && !strings.HasPrefix(line, "/") {
+				line = "/" + line
+			}
+			patterns = append(patterns, line)
+		}
+	}
+	return patterns
+}
+
+// isIgnored reports whether path is inside an ignored directory or matches one of the ignore patterns.
+// Patterns starting with a slash match from the root, and the others match any part of the path
+func isIgnored(root, path string, patterns []string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		rel = path
+	}
+	rel = filepath.ToSlash(rel)
+
+	for _, part := range strings.Split(rel, "/") {
+		for _, dir := range watchIgnoredDirs {
+			if part == dir {
+				return true
+			}
+		}
+		for _, pattern := range patterns {
+			if strings.HasPrefix(pattern, "/") {
+				continue
+			}
+			if ok, _ := filepath.Match(pattern, part); ok {
+				return true
+			}
+		}
+	}
+
+	for _, pattern := range patterns {
+		pattern = strings.TrimPrefix(pattern, "/")
+		if ok, _ := filepath.Match(pattern, rel); ok {
+			return true
+		}
+		if strings.HasPrefix(rel, pattern+"/") {
+			return true
+		}
+	}
+
+	return false
+}
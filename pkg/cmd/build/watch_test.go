@@ -0,0 +1,103 @@
+package build
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/fsnotify/fsnotify"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+type fakeWatcher struct {
+	added  []string
+	events chan fsnotify.Event
+	errors chan error
+}
+
+func (w *fakeWatcher) Add(name string) error {
+	w.added = append(w.added, name)
+	return nil
+}
+
+func (w *fakeWatcher) Close() error                  { return nil }
+func (w *fakeWatcher) Events() <-chan fsnotify.Event { return w.events }
+func (w *fakeWatcher) Errors() <-chan error          { return w.errors }
+
+func TestRunWatch(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	root := t.TempDir()
+	for _, dir := range []string{"src", "out", "node_modules/pkg", ".edge", "ignored", "azion", "lib/gen"} {
+		_ = os.MkdirAll(filepath.Join(root, dir), os.ModePerm)
+	}
+	_ = os.WriteFile(filepath.Join(root, ".edge", ".env"), []byte("VERSION_ID=123"), 0644)
+	_ = os.WriteFile(filepath.Join(root, ".gitignore"), []byte("# comment\nignored/\n*.log\nlib/gen\n"), 0644)
+
+	f, stdout, _ := testutils.NewFactory(nil)
+	watcher := &fakeWatcher{events: make(chan fsnotify.Event), errors: make(chan error)}
+	builds := make(chan struct{}, 10)
+
+	cmd := NewBuildCmd(f)
+	cmd.CommandRunInteractive = func(f *cmdutil.Factory, comm string, envVars []string) error {
+		return nil
+	}
+	cmd.WriteAzionJsonContent = func(conf *contracts.AzionApplicationOptions) error {
+		return nil
+	}
+	cmd.WatchDebounce = 20 * time.Millisecond
+	cmd.NewWatcher = func() (FileWatcher, error) {
+		return watcher, nil
+	}
+	cmd.GetAzionJsonContent = func() (*contracts.AzionApplicationOptions, error) {
+		builds <- struct{}{}
+		return &contracts.AzionApplicationOptions{Template: "vue", ProjectRoot: root}, nil
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- cmd.RunWatch(ctx, root)
+	}()
+
+	// the build output of the preset is read before watching
+	<-builds
+
+	// changes in ignored paths do not trigger a build, and node_modules is ignored without .gitignore listing it
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "node_modules/pkg/index.js"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, ".edge/worker.js"), Op: fsnotify.Create}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "ignored/file.js"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "debug.log"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "lib/gen/types.js"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "azion/azion.json"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "src/index.js"), Op: fsnotify.Chmod}
+
+	// a burst of changes triggers a single build
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "src/index.js"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "src/index.js"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "src/app.js"), Op: fsnotify.Create}
+	// out and azion are only ignored when the build writes them
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "out/index.js"), Op: fsnotify.Write}
+	watcher.events <- fsnotify.Event{Name: filepath.Join(root, "azion/kv.js"), Op: fsnotify.Write}
+
+	select {
+	case <-builds:
+	case <-time.After(2 * time.Second):
+		t.Fatal("expected a rebuild after a file change")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	stop()
+	require.NoError(t, <-done)
+
+	require.Len(t, builds, 0)
+	require.ElementsMatch(t, []string{root, filepath.Join(root, "src"), filepath.Join(root, "out"), filepath.Join(root, "azion"), filepath.Join(root, "lib")}, watcher.added)
+	require.Contains(t, stdout.String(), "Rebuilt in")
+}
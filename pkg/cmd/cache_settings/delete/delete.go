@@ -8,6 +8,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/cache_settings"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -24,8 +25,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion cache_settings delete --application-id 1673635839 --cache-settings-id 107313
-        $ azion cache_settings delete -a 1673635839 -c 107313
+        $ azion delete cache-setting --application-id 1673635839 --cache-settings-id 107313
+        $ azion delete cache-setting -a 1673635839 -c 107313
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("cache-settings-id") {
@@ -33,7 +34,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 			if err := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token")).
 				DeleteCacheSettings(context.Background(), applicationID, cacheSettingsID); err != nil {
-				return fmt.Errorf(msg.ErrorFailToDelete.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDelete, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.CacheSettingsDeleteOutputSuccess, cacheSettingsID)
 			return nil
@@ -41,7 +42,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.CacheSettingsDeleteFlagApplicationID)
-	cmd.Flags().Int64VarP(&cacheSettingsID, "cache-settings-id", "c", 0, msg.CacheSettingsDeleteFlagCacheSettingsID)
+	cmd.Flags().Int64Var(&cacheSettingsID, "cache-settings-id", 0, msg.CacheSettingsDeleteFlagCacheSettingsID)
 	cmd.Flags().BoolP("help", "h", false, msg.CacheSettingsDeleteHelpFlag)
 	return cmd
 }
@@ -2,18 +2,14 @@ package describe
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/cache_settings"
 
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -32,9 +28,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion cache_settings describe --application-id 1673635839 --cache-settings-id 107313
-        $ azion cache_settings describe --application-id 1673635839 --cache-settings-id 107313 --format json
-        $ azion cache_settings describe --application-id 1673635839 --cache-settings-id 107313 --out "./tmp/test.json" --format json
+        $ azion describe cache-setting --application-id 1673635839 --cache-settings-id 107313
+        $ azion describe cache-setting --application-id 1673635839 --cache-settings-id 107313 --format json
+        $ azion describe cache-setting --application-id 1673635839 --cache-settings-id 107313 --out "./tmp/test.json" --format json
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("cache-settings-id") {
@@ -45,66 +41,41 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			ctx := context.Background()
 			resp, err := client.GetCacheSettings(ctx, applicationID, cacheSettingsID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorGetCache.Error(), err)
+				return utils.WrapError(msg.ErrorGetCache, err)
 			}
 
-			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, resp)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.CacheSettingsFileWritten, opts.OutPath)
-			} else {
-				_, err := out.Write(formattedFuction[:])
-				if err != nil {
-					return err
-				}
+				return err
 			}
 
-			return nil
+			return p.Describe(resp, fields(resp))
 		},
 	}
 
 	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.CacheSettingsDescribeFlagApplicationID)
-	cmd.Flags().Int64VarP(&cacheSettingsID, "cache-settings-id", "c", 0, msg.CacheSettingsDescribeFlagCacheSettingsID)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.CacheSettingsDescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.CacheSettingsDescribeFlagFormat)
+	cmd.Flags().Int64Var(&cacheSettingsID, "cache-settings-id", 0, msg.CacheSettingsDescribeFlagCacheSettingsID)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.CacheSettingsDescribeHelpFlag)
 	return cmd
 }
 
-func format(cmd *cobra.Command, strResp api.GetCacheSettingsResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+func fields(strResp api.GetCacheSettingsResponse) []printer.Field {
+	return []printer.Field{
+		{Label: "Id", Value: strResp.GetId()},
+		{Label: "Name", Value: strResp.GetName()},
+		{Label: "Browser cache settings", Value: strResp.GetBrowserCacheSettings()},
+		{Label: "Browser cache settings maximum TTL", Value: strResp.GetBrowserCacheSettingsMaximumTtl()},
+		{Label: "Cdn cache settings", Value: strResp.GetCdnCacheSettings()},
+		{Label: "Cdn cache settings maximum TTL", Value: strResp.GetCdnCacheSettingsMaximumTtl()},
+		{Label: "Cache by query string", Value: strResp.GetCacheByQueryString()},
+		{Label: "Query string fields", Value: strResp.GetQueryStringFields()},
+		{Label: "Enable query string sort", Value: strResp.GetEnableCachingForPost()},
+		{Label: "Cache by cookies", Value: strResp.GetCacheByCookies()},
+		{Label: "Cookie Names", Value: strResp.GetCookieNames()},
+		{Label: "Adaptive delivery action", Value: strResp.GetAdaptiveDeliveryAction()},
+		{Label: "Device group", Value: strResp.GetDeviceGroup()},
+		{Label: "EnableCachingForPost", Value: strResp.GetEnableCachingForPost()},
+		{Label: "L2 caching enabled", Value: strResp.GetL2CachingEnabled()},
 	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(strResp, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Id: ", strResp.GetId())
-	tbl.AddRow("Name: ", strResp.GetName())
-	tbl.AddRow("Browser cache settings: ", strResp.GetBrowserCacheSettings())
-	tbl.AddRow("Browser cache settings maximum TTL: ", strResp.GetBrowserCacheSettingsMaximumTtl())
-	tbl.AddRow("Cdn cache settings: ", strResp.GetCdnCacheSettings())
-	tbl.AddRow("Cdn cache settings maximum TTL: ", strResp.GetCdnCacheSettingsMaximumTtl())
-	tbl.AddRow("Cache by query string: ", strResp.GetCacheByQueryString())
-	tbl.AddRow("Query string fields: ", strResp.GetQueryStringFields())
-	tbl.AddRow("Enable query string sort: ", strResp.GetEnableCachingForPost())
-	tbl.AddRow("Cache by cookies: ", strResp.GetCacheByCookies())
-	tbl.AddRow("Cookie Names: ", strResp.GetCookieNames())
-	tbl.AddRow("Adaptive delivery action: ", strResp.GetAdaptiveDeliveryAction())
-	tbl.AddRow("Device group: ", strResp.GetDeviceGroup())
-	tbl.AddRow("EnableCachingForPost: ", strResp.GetEnableCachingForPost())
-	tbl.AddRow("L2 caching enabled: ", strResp.GetL2CachingEnabled())
-	return tbl.GetByteFormat(), nil
 }
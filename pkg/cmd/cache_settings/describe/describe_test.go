@@ -11,6 +11,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	msg "github.com/aziontech/azion-cli/messages/cache_settings"
+	"github.com/aziontech/azion-cli/messages/general"
 	"github.com/aziontech/azion-cli/pkg/httpmock"
 	"github.com/aziontech/azion-cli/pkg/testutils"
 	"github.com/stretchr/testify/require"
@@ -29,7 +30,7 @@ func TestDescribe(t *testing.T) {
 		f, _, _ := testutils.NewFactory(mock)
 
 		cmd := NewCmd(f)
-		cmd.SetArgs([]string{"-a", "1673635839", "-c", "107313"})
+		cmd.SetArgs([]string{"-a", "1673635839", "--cache-settings-id", "107313"})
 
 		err := cmd.Execute()
 		require.NoError(t, err)
@@ -75,7 +76,7 @@ func TestDescribe(t *testing.T) {
 
 		cmd := NewCmd(f)
 		path := "out.json"
-		cmd.SetArgs([]string{"-a", "1673635839", "-c", "107313", "--out", path})
+		cmd.SetArgs([]string{"-a", "1673635839", "--cache-settings-id", "107313", "--out", path})
 
 		err := cmd.Execute()
 		if err != nil {
@@ -92,6 +93,6 @@ func TestDescribe(t *testing.T) {
 
 		require.NoError(t, err)
 
-		require.Equal(t, fmt.Sprintf(msg.CacheSettingsFileWritten, path), stdout.String())
+		require.Equal(t, fmt.Sprintf(general.FileWritten, path), stdout.String())
 	})
 }
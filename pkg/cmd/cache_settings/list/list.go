@@ -2,16 +2,13 @@ package list
 
 import (
 	"context"
-	"strings"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/cache_settings"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/spf13/cobra"
 )
 
@@ -26,11 +23,12 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion cache_settings --application-id 16736354321 list --details
-        $ azion cache_settings --application-id 16736354321 list --order_by "id"
-        $ azion cache_settings --application-id 16736354321 list --page 1  
-        $ azion cache_settings --application-id 16736354321 list --page_size 5
-        $ azion cache_settings --application-id 16736354321 list --sort "asc" 
+        $ azion list cache-setting --application-id 16736354321 --details
+        $ azion list cache-setting --application-id 16736354321 --order_by "id"
+        $ azion list cache-setting --application-id 16736354321 --page 1  
+        $ azion list cache-setting --application-id 16736354321 --page_size 5
+        $ azion list cache-setting --application-id 16736354321 --sort "asc" 
+        $ azion list cache-setting --application-id 16736354321 --format csv
         `),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -38,12 +36,23 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return msg.ErrorMandatoryListFlags
 			}
 
+			headers := []string{"ID", "NAME", "BROWSER CACHE SETTINGS"}
+			if cmd.Flags().Changed("details") {
+				headers = append(headers, "CDN CACHE SETTINGS", "CACHE BY COOKIES", "ENABLE CACHING FOR POST")
+			}
+
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+			if err != nil {
+				return err
+			}
+			p.NoHeader = opts.Page > 1
+
 			var numberPage int64 = opts.Page
 			if !cmd.Flags().Changed("page") && !cmd.Flags().Changed("page_size") {
 				for {
-					pages, err := PrintTable(cmd, f, opts, &numberPage)
+					pages, err := PrintTable(cmd, f, opts, &numberPage, p)
 					if numberPage > pages && err == nil {
-						return nil
+						return p.Close()
 					}
 					if err != nil {
 						return msg.ErrorGetCache
@@ -51,20 +60,21 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				}
 			}
 
-			if _, err := PrintTable(cmd, f, opts, &numberPage); err != nil {
+			if _, err := PrintTable(cmd, f, opts, &numberPage, p); err != nil {
 				return msg.ErrorGetCache
 			}
-			return nil
+			return p.Close()
 		},
 	}
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
-	cmd.Flags().Int64VarP(&edgeApplicationID, "application-id", "a", 0, "")
-	cmd.Flags().BoolP("help", "h", false, msg.CacheSettingsFlagHelp)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
+	cmd.Flags().Int64VarP(&edgeApplicationID, "application-id", "a", 0, msg.CacheSettingsListFlagEdgeApplicationID)
+	cmd.Flags().BoolP("help", "h", false, msg.CacheSettingsListHelpFlag)
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, numberPage *int64) (int64, error) {
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, numberPage *int64, p *printer.Printer) (int64, error) {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
 
@@ -73,32 +83,12 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		return 0, msg.ErrorGetCache
 	}
 
-	tbl := table.New("ID", "NAME", "BROWSER CACHE SETTINGS")
-	tbl.WithWriter(f.IOStreams.Out)
-	if cmd.Flags().Changed("details") {
-		tbl = table.New("ID", "NAME", "BROWSER CACHE SETTINGS", "CDN CACHE SETTINGS", "CACHE BY COOKIES", "ENABLE CACHING FOR POST")
-	}
-
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
 	for _, v := range applications.Results {
-		if cmd.Flags().Changed("details") {
-			tbl.AddRow(v.Id, v.Name, v.BrowserCacheSettings, v.CdnCacheSettings, v.CacheByCookies, v.EnableCachingForPost)
-		} else {
-			tbl.AddRow(v.Id, v.Name, v.BrowserCacheSettings)
-		}
-	}
-
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	if *numberPage == 1 {
-		tbl.PrintHeader(format)
+		p.AddRow(v, v.Id, v.Name, v.BrowserCacheSettings, v.CdnCacheSettings, v.CacheByCookies, v.EnableCachingForPost)
 	}
 
-	for _, row := range tbl.GetRows() {
-		tbl.PrintRow(format, row)
+	if err := p.Flush(); err != nil {
+		return 0, err
 	}
 
 	*numberPage += 1
@@ -51,10 +51,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion cache_settings update -a 1673635839 -c 115247 --name "cachesettingstest"
-        $ azion cache_settings update -a 1673635839 -c 115247 --name "cachesettingswithfields" --browser-cache-settings honor --cdn-cache-settings honor --cache-by-query-string ignore 
-        $ azion cache_settings update -a 1673635839 --in "update.json"
-        $ azion cache_settings update -a 1674767911 -c 115247 --name "updateagain" --browser-cache-settings override --browser-cache-settings-maximum-ttl 60  --cdn-cache-settings honor --cnd-cache-settings-maximum-ttl 60 --cache-by-query-string ignore --cache-by-query-string whitelist --query-string-fields "heyyy,yoooo" --adaptive-delivery-action ignore --cache-by-cookies blacklist --cookie-names "nem,vem" --enable-caching-for-options true --enable-caching-for-post true --enable-caching-string-sort true --slice-configuration-enabled true
+        $ azion update cache-setting -a 1673635839 -c 115247 --name "cachesettingstest"
+        $ azion update cache-setting -a 1673635839 -c 115247 --name "cachesettingswithfields" --browser-cache-settings honor --cdn-cache-settings honor --cache-by-query-string ignore 
+        $ azion update cache-setting -a 1673635839 --in "update.json"
+        $ azion update cache-setting -a 1674767911 -c 115247 --name "updateagain" --browser-cache-settings override --browser-cache-settings-maximum-ttl 60  --cdn-cache-settings honor --cnd-cache-settings-maximum-ttl 60 --cache-by-query-string ignore --cache-by-query-string whitelist --query-string-fields "heyyy,yoooo" --adaptive-delivery-action ignore --cache-by-cookies blacklist --cookie-names "nem,vem" --enable-caching-for-options true --enable-caching-for-post true --enable-caching-string-sort true --slice-configuration-enabled true
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
@@ -218,7 +218,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			response, err := client.UpdateCacheSettings(context.Background(), &request, fields.ApplicationID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreateCacheSettings.Error(), err)
+				return utils.WrapError(msg.ErrorCreateCacheSettings, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.CacheSettingsUpdateOutputSuccess, response.GetId())
 			return nil
@@ -227,7 +227,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 	flags := cmd.Flags()
 	flags.Int64VarP(&fields.ApplicationID, "application-id", "a", 0, msg.CacheSettingsCreateFlagEdgeApplicationId)
-	flags.Int64VarP(&fields.CacheSettingsID, "cache-settings-id", "c", 0, msg.CacheSettingsId)
+	flags.Int64Var(&fields.CacheSettingsID, "cache-settings-id", 0, msg.CacheSettingsId)
 	flags.StringVar(&fields.Name, "name", "", msg.CacheSettingsCreateFlagName)
 	flags.StringVar(&fields.browser_cache_settings, "browser-cache-settings", "honor", msg.CacheSettingsCreateFlagBrowserCacheSettings)
 	flags.StringSliceVar(&fields.query_string_fields, "query-string-fields", []string{}, msg.CacheSettingsCreateFlagQueryStringFields)
@@ -32,7 +32,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -149,7 +149,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -190,7 +190,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -230,7 +230,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -271,7 +271,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -312,7 +312,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -353,7 +353,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -394,7 +394,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -435,7 +435,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -476,7 +476,7 @@ func TestCreate(t *testing.T) {
 		cmd := NewCmd(f)
 		cmd.SetArgs([]string{
 			"-a", "1673635841",
-			"-c", "112233",
+			"--cache-settings-id", "112233",
 			"--name", "fmaiswaybetter",
 			"--adaptive-delivery-action", "ignore",
 			"--browser-cache-settings", "override",
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/get"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/list"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/set"
+	"github.com/aziontech/azion-cli/pkg/cmd/config/unset"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   msg.ConfigUsage,
+		Short: msg.ConfigShortDescription,
+		Long:  msg.ConfigLongDescription,
+		Example: heredoc.Doc(`
+		$ azion config --help
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	configCmd.AddCommand(get.NewCmd(f))
+	configCmd.AddCommand(set.NewCmd(f))
+	configCmd.AddCommand(unset.NewCmd(f))
+	configCmd.AddCommand(list.NewCmd(f))
+	configCmd.Flags().BoolP("help", "h", false, msg.ConfigFlagHelp)
+	return configCmd
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestConfig(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	config.SetPath(t.TempDir())
+	defer config.SetPath(".azion")
+
+	run := func(args ...string) (string, error) {
+		f, stdout, _ := testutils.NewFactory(nil)
+		settings, err := config.ReadSettings()
+		require.NoError(t, err)
+		for key, value := range settings {
+			f.Config.SetDefault(key, value)
+		}
+
+		cmd := NewCmd(f)
+		cmd.SetArgs(args)
+		_, err = cmd.ExecuteC()
+		return stdout.String(), err
+	}
+
+	t.Run("set setting", func(t *testing.T) {
+		out, err := run("set", "http_timeout", "30s")
+		require.NoError(t, err)
+		require.Contains(t, out, "Setting http_timeout saved as 30s")
+
+		settings, err := config.ReadSettings()
+		require.NoError(t, err)
+		require.Equal(t, "30s", settings["http_timeout"])
+	})
+
+	t.Run("get setting", func(t *testing.T) {
+		out, err := run("get", "http_timeout")
+		require.NoError(t, err)
+		require.Equal(t, "30s\n", out)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := run("set", "timeout", "30s")
+		require.ErrorContains(t, err, "unknown")
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		_, err := run("set", "color", "purple")
+		require.ErrorContains(t, err, "auto, always, never")
+
+		_, err = run("set", "upload_concurrency", "0")
+		require.ErrorContains(t, err, "positive number")
+	})
+
+	t.Run("list settings", func(t *testing.T) {
+		out, err := run("list")
+		require.NoError(t, err)
+		require.Contains(t, out, "http_timeout")
+		require.Contains(t, out, "output_format")
+	})
+
+	t.Run("template source", func(t *testing.T) {
+		out, err := run("set", "templates.Internal.repo", "https://github.com/acme/templates")
+		require.NoError(t, err)
+		require.Contains(t, out, "Setting templates.Internal.repo saved")
+
+		_, err = run("set", "templates.internal.ref", "v1")
+		require.NoError(t, err)
+
+		out, err = run("get", "templates.internal.repo")
+		require.NoError(t, err)
+		require.Equal(t, "https://github.com/acme/templates\n", out)
+
+		out, err = run("list")
+		require.NoError(t, err)
+		require.Contains(t, out, "templates.internal.ref")
+
+		sources, err := config.TemplateSources()
+		require.NoError(t, err)
+		require.Equal(t, []config.TemplateSource{{Name: "internal", Repo: "https://github.com/acme/templates", Ref: "v1"}}, sources)
+
+		_, err = run("set", "templates.internal.repo", "")
+		require.ErrorContains(t, err, "must not be empty")
+
+		_, err = run("set", "templates.internal.branch", "main")
+		require.ErrorContains(t, err, "unknown")
+
+		_, err = run("unset", "templates.internal.ref")
+		require.NoError(t, err)
+		sources, err = config.TemplateSources()
+		require.NoError(t, err)
+		require.Empty(t, sources[0].Ref)
+	})
+
+	t.Run("unset setting", func(t *testing.T) {
+		out, err := run("unset", "http_timeout")
+		require.NoError(t, err)
+		require.Contains(t, out, "Setting http_timeout removed")
+
+		settings, err := config.ReadSettings()
+		require.NoError(t, err)
+		require.NotContains(t, settings, "http_timeout")
+	})
+}
@@ -0,0 +1,39 @@
+package get
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.ConfigGetUsage,
+		Short:         msg.ConfigGetShortDescription,
+		Long:          msg.ConfigGetLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion config get http_timeout
+		$ azion config get api_url --profile stage
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			if _, ok := config.LookupSetting(key); !ok {
+				return fmt.Errorf(msg.ErrorUnknownKey.Error(), key)
+			}
+
+			logger.FInfo(f.IOStreams.Out, f.Config.GetString(key)+"\n")
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.ConfigGetHelpFlag)
+	return cmd
+}
@@ -0,0 +1,94 @@
+package list
+
+import (
+	"sort"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// listItem is the JSON and YAML view of a setting
+type listItem struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Saved       bool   `json:"saved"`
+	Description string `json:"description"`
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	output := &contracts.OutputOptions{}
+	cmd := &cobra.Command{
+		Use:           msg.ConfigListUsage,
+		Short:         msg.ConfigListShortDescription,
+		Long:          msg.ConfigListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion config list
+		$ azion config list --format yaml
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			settings, err := config.ReadSettings()
+			if err != nil {
+				logger.Debug("Error while reading settings", zap.Error(err))
+				return utils.ErrorReadingSettings
+			}
+
+			p, err := cmdutil.NewPrinter(f, output, "KEY", "VALUE", "FILE", "DESCRIPTION")
+			if err != nil {
+				return err
+			}
+
+			for _, setting := range config.Settings {
+				_, ok := settings[setting.Key]
+				saved := ""
+				if ok {
+					saved = msg.ConfigListSaved
+				}
+
+				item := listItem{
+					Key:         setting.Key,
+					Value:       f.Config.GetString(setting.Key),
+					Saved:       ok,
+					Description: setting.Description,
+				}
+				p.AddRow(item, item.Key, item.Value, saved, item.Description)
+			}
+
+			// template sources only exist once saved, so they are listed after the other settings
+			var templateKeys []string
+			for key := range settings {
+				if config.IsTemplateKey(key) {
+					templateKeys = append(templateKeys, key)
+				}
+			}
+			sort.Strings(templateKeys)
+			for _, key := range templateKeys {
+				setting, _ := config.LookupSetting(key)
+				item := listItem{
+					Key:         key,
+					Value:       settings[key],
+					Saved:       true,
+					Description: setting.Description,
+				}
+				p.AddRow(item, item.Key, item.Value, msg.ConfigListSaved, item.Description)
+			}
+
+			if err := p.Flush(); err != nil {
+				return err
+			}
+			return p.Close()
+		},
+	}
+
+	cmdutil.AddOutputFlags(cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.ConfigListHelpFlag)
+	return cmd
+}
@@ -0,0 +1,60 @@
+package set
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.ConfigSetUsage,
+		Short:         msg.ConfigSetShortDescription,
+		Long:          msg.ConfigSetLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(2),
+		Example: heredoc.Doc(`
+		$ azion config set http_timeout 30s
+		$ azion config set output_format json
+		$ azion config set color never
+		$ azion config set templates.internal.repo https://github.com/acme/templates
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, value := args[0], args[1]
+
+			setting, ok := config.LookupSetting(key)
+			if !ok {
+				return fmt.Errorf(msg.ErrorUnknownKey.Error(), key)
+			}
+			if err := setting.Validate(value); err != nil {
+				return fmt.Errorf(msg.ErrorInvalidValue.Error(), value, key, err)
+			}
+
+			settings, err := config.ReadSettings()
+			if err != nil {
+				logger.Debug("Error while reading settings", zap.Error(err))
+				return utils.ErrorReadingSettings
+			}
+
+			settings[setting.Key] = value
+			if err := config.WriteSettings(settings); err != nil {
+				logger.Debug("Error while writing settings", zap.Error(err))
+				return msg.ErrorWritingSettings
+			}
+
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ConfigSetOutputSuccess, key, value))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.ConfigSetHelpFlag)
+	return cmd
+}
@@ -0,0 +1,58 @@
+package unset
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/config"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.ConfigUnsetUsage,
+		Short:         msg.ConfigUnsetShortDescription,
+		Long:          msg.ConfigUnsetLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion config unset http_timeout
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key := args[0]
+			setting, ok := config.LookupSetting(key)
+			if !ok {
+				return fmt.Errorf(msg.ErrorUnknownKey.Error(), key)
+			}
+
+			settings, err := config.ReadSettings()
+			if err != nil {
+				logger.Debug("Error while reading settings", zap.Error(err))
+				return utils.ErrorReadingSettings
+			}
+
+			if _, ok := settings[setting.Key]; !ok {
+				logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ConfigUnsetNotSet, key))
+				return nil
+			}
+
+			delete(settings, setting.Key)
+			if err := config.WriteSettings(settings); err != nil {
+				logger.Debug("Error while writing settings", zap.Error(err))
+				return msg.ErrorWritingSettings
+			}
+
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ConfigUnsetOutputSuccess, key))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.ConfigUnsetHelpFlag)
+	return cmd
+}
@@ -3,10 +3,18 @@ package create
 import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/create"
+	cacheSettings "github.com/aziontech/azion-cli/pkg/cmd/cache_settings/create"
 	domains "github.com/aziontech/azion-cli/pkg/cmd/create/domains"
 	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/create/edge_applications"
 	token "github.com/aziontech/azion-cli/pkg/cmd/create/personal_token"
 	rulesEngine "github.com/aziontech/azion-cli/pkg/cmd/create/rules_engine"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/device_groups/create"
+	edgeFunctions "github.com/aziontech/azion-cli/pkg/cmd/edge_functions/create"
+	functionInstances "github.com/aziontech/azion-cli/pkg/cmd/edge_functions_instances/create"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/edge_services/create"
+	serviceResources "github.com/aziontech/azion-cli/pkg/cmd/edge_services/resources/create"
+	origins "github.com/aziontech/azion-cli/pkg/cmd/origins/create"
+	variables "github.com/aziontech/azion-cli/pkg/cmd/variables/create"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +25,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: msg.ShortDescription,
 		Long:  msg.LongDescription, Example: heredoc.Doc(`
 		$ azion create --help
+		$ azion create origin --application-id 1673635839 --name "my origin" --addresses "example.com" --host-header "example.com"
 		$ azion create edge-application -h
 		$ azion create rules-engine -h
         `),
@@ -29,6 +38,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(rulesEngine.NewCmd(f))
 	cmd.AddCommand(domains.NewCmd(f))
 	cmd.AddCommand(token.NewCmd(f))
+	cmd.AddCommand(origins.NewCmd(f))
+	cmd.AddCommand(cacheSettings.NewCmd(f))
+	cmd.AddCommand(edgeFunctions.NewCmd(f))
+	cmd.AddCommand(functionInstances.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(serviceResources.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
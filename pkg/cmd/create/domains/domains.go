@@ -105,7 +105,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.Create(context.Background(), request)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+				return utils.WrapError(msg.ErrorCreate, err)
 			}
 
 			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.OutputSuccess, response.GetId()))
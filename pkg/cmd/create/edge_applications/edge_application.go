@@ -85,7 +85,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"),
 			).Create(context.Background(), &request)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+				return utils.WrapError(msg.ErrorCreate, err)
 			}
 
 			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.OutputSuccess, response.GetId()))
@@ -100,7 +100,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			response, err := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token")).Create(context.Background(), &request)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+				return utils.WrapError(msg.ErrorCreate, err)
 			}
 
 			fmt.Fprintf(f.IOStreams.Out, msg.CreateOutputSuccess, response.GetKey())
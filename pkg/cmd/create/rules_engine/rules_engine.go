@@ -88,7 +88,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			response, err := client.Create(context.Background(), fields.ApplicationID, fields.Phase, reqSdk)
 
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreateRulesEngine.Error(), err)
+				return utils.WrapError(msg.ErrorCreateRulesEngine, err)
 			}
 
 			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.OutputSuccess, response.GetId()))
@@ -0,0 +1,27 @@
+package credentials
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/credentials"
+	"github.com/aziontech/azion-cli/pkg/cmd/credentials/migrate"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	credentialsCmd := &cobra.Command{
+		Use:   msg.CredentialsUsage,
+		Short: msg.CredentialsShortDescription,
+		Long:  msg.CredentialsLongDescription,
+		Example: heredoc.Doc(`
+		$ azion credentials --help
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	credentialsCmd.AddCommand(migrate.NewCmd(f))
+	credentialsCmd.Flags().BoolP("help", "h", false, msg.CredentialsFlagHelp)
+	return credentialsCmd
+}
@@ -0,0 +1,108 @@
+package migrate
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/credentials"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/iostreams"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+type MigrateCmd struct {
+	Io            *iostreams.IOStreams
+	F             *cmdutil.Factory
+	AskPassphrase func(msg string) (string, error)
+	Migrate       func(passphrase string) ([]string, error)
+}
+
+func NewMigrateCmd(f *cmdutil.Factory) *MigrateCmd {
+	return &MigrateCmd{
+		Io:            f.IOStreams,
+		F:             f,
+		AskPassphrase: utils.AskPassword,
+		Migrate:       token.Migrate,
+	}
+}
+
+func NewCobraCmd(migrate *MigrateCmd) *cobra.Command {
+	cobraCmd := &cobra.Command{
+		Use:           msg.CredentialsMigrateUsage,
+		Short:         msg.CredentialsMigrateShortDescription,
+		Long:          msg.CredentialsMigrateLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion credentials migrate
+		$ AZIONCLI_CREDENTIALS_PASSPHRASE=<passphrase> azion credentials migrate
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return migrate.Run()
+		},
+	}
+
+	cobraCmd.Flags().BoolP("help", "h", false, msg.CredentialsMigrateHelpFlag)
+	return cobraCmd
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	return NewCobraCmd(NewMigrateCmd(f))
+}
+
+func (cmd *MigrateCmd) Run() error {
+	logger.Debug("Running credentials migrate command")
+
+	passphrase, fromEnv, err := cmd.readPassphrase()
+	if err != nil {
+		return err
+	}
+
+	migrated, err := cmd.Migrate(passphrase)
+	for _, location := range migrated {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.CredentialsMigrateEncrypted, location))
+	}
+	if err != nil {
+		logger.Debug("Error while encrypting the saved tokens", zap.Error(err))
+		return utils.WrapError(msg.ErrorMigrate, err)
+	}
+
+	if len(migrated) == 0 {
+		logger.FInfo(cmd.Io.Out, msg.CredentialsMigrateNothing)
+		return nil
+	}
+
+	if !fromEnv {
+		logger.FInfo(cmd.Io.Out, msg.CredentialsMigrateRemember)
+	}
+	return nil
+}
+
+// readPassphrase returns the passphrase of AZIONCLI_CREDENTIALS_PASSPHRASE or asks for a new one
+func (cmd *MigrateCmd) readPassphrase() (string, bool, error) {
+	if passphrase := token.EnvPassphrase(); passphrase != "" {
+		return passphrase, true, nil
+	}
+
+	if !cmd.F.CanPrompt() {
+		return "", false, msg.ErrorPassphraseRequired
+	}
+
+	passphrase, err := cmd.AskPassphrase(msg.CredentialsMigrateAskPassphrase)
+	if err != nil {
+		return "", false, err
+	}
+
+	confirm, err := cmd.AskPassphrase(msg.CredentialsMigrateAskConfirm)
+	if err != nil {
+		return "", false, err
+	}
+	if passphrase != confirm {
+		return "", false, msg.ErrorPassphraseMismatch
+	}
+	return passphrase, false, nil
+}
@@ -0,0 +1,62 @@
+package migrate
+
+import (
+	"errors"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/credentials"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestMigrate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("passphrase from env", func(t *testing.T) {
+		t.Setenv(token.PassphraseEnv, "s3cret")
+		f, stdout, _ := testutils.NewFactory(nil)
+
+		migrateCmd := NewMigrateCmd(f)
+		migrateCmd.Migrate = func(passphrase string) ([]string, error) {
+			require.Equal(t, "s3cret", passphrase)
+			return []string{"/home/user/.azion/credentials"}, nil
+		}
+
+		cmd := NewCobraCmd(migrateCmd)
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "Token encrypted in /home/user/.azion/credentials")
+	})
+
+	t.Run("passphrases don't match", func(t *testing.T) {
+		t.Setenv(token.PassphraseEnv, "")
+		f, _, _ := testutils.NewFactory(nil)
+		f.IOStreams.SetStdinTTY(true)
+
+		answers := []string{"s3cret", "other"}
+		migrateCmd := NewMigrateCmd(f)
+		migrateCmd.AskPassphrase = func(string) (string, error) {
+			answer := answers[0]
+			answers = answers[1:]
+			return answer, nil
+		}
+		migrateCmd.Migrate = func(string) ([]string, error) {
+			return nil, errors.New("unexpected migration")
+		}
+
+		err := migrateCmd.Run()
+		require.ErrorIs(t, err, msg.ErrorPassphraseMismatch)
+	})
+
+	t.Run("no passphrase and no prompt", func(t *testing.T) {
+		t.Setenv(token.PassphraseEnv, "")
+		f, _, _ := testutils.NewFactory(nil)
+		f.NoInput = true
+
+		err := NewMigrateCmd(f).Run()
+		require.ErrorIs(t, err, msg.ErrorPassphraseRequired)
+	})
+}
@@ -3,10 +3,18 @@ package delete
 import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/delete"
+	cacheSettings "github.com/aziontech/azion-cli/pkg/cmd/cache_settings/delete"
 	domains "github.com/aziontech/azion-cli/pkg/cmd/delete/domains"
 	edgeApplication "github.com/aziontech/azion-cli/pkg/cmd/delete/edge_application"
 	token "github.com/aziontech/azion-cli/pkg/cmd/delete/personal_token"
 	rulesEngine "github.com/aziontech/azion-cli/pkg/cmd/delete/rules_engine"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/device_groups/delete"
+	edgeFunctions "github.com/aziontech/azion-cli/pkg/cmd/edge_functions/delete"
+	functionInstances "github.com/aziontech/azion-cli/pkg/cmd/edge_functions_instances/delete"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/edge_services/delete"
+	serviceResources "github.com/aziontech/azion-cli/pkg/cmd/edge_services/resources/delete"
+	origins "github.com/aziontech/azion-cli/pkg/cmd/origins/delete"
+	variables "github.com/aziontech/azion-cli/pkg/cmd/variables/delete"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +25,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: msg.ShortDescription,
 		Long:  msg.LongDescription, Example: heredoc.Doc(`
 		$ azion delete --help
+		$ azion delete device-group --application-id 1673635839 --group-id 1234
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
@@ -27,6 +36,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(rulesEngine.NewCmd(f))
 	cmd.AddCommand(domains.NewCmd(f))
 	cmd.AddCommand(token.NewCmd(f))
+	cmd.AddCommand(origins.NewCmd(f))
+	cmd.AddCommand(cacheSettings.NewCmd(f))
+	cmd.AddCommand(edgeFunctions.NewCmd(f))
+	cmd.AddCommand(functionInstances.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(serviceResources.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
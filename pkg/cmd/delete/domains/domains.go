@@ -50,7 +50,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			err := client.Delete(ctx, domain_id)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDeleteDomain.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDeleteDomain, err)
 			}
 
 			out := f.IOStreams.Out
@@ -86,7 +86,7 @@ func (del *DeleteCmd) run(cmd *cobra.Command, application_id int64) error {
 
 		err = clientapp.Delete(ctx, azionJson.Application.Id)
 		if err != nil {
-			return fmt.Errorf(msg.ErrorFailToDeleteApplication.Error(), err)
+			return utils.WrapError(msg.ErrorFailToDeleteApplication, err)
 		}
 
 		if azionJson.Function.Id == 0 {
@@ -94,7 +94,7 @@ func (del *DeleteCmd) run(cmd *cobra.Command, application_id int64) error {
 		} else {
 			err = clientfunc.Delete(ctx, azionJson.Function.Id)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDeleteApplication.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDeleteApplication, err)
 			}
 		}
 
@@ -139,7 +139,7 @@ func (del *DeleteCmd) run(cmd *cobra.Command, application_id int64) error {
 
 	err := client.Delete(ctx, application_id)
 	if err != nil {
-		return fmt.Errorf(msg.ErrorFailToDeleteApplication.Error(), err)
+		return utils.WrapError(msg.ErrorFailToDeleteApplication, err)
 	}
 
 	out := del.f.IOStreams.Out
@@ -43,7 +43,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			err := client.Delete(context.Background(), id)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDelete.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDelete, err)
 			}
 
 			out := f.IOStreams.Out
@@ -78,7 +78,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			err := client.Delete(ctx, app_id, phase, rule_id)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDelete.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDelete, err)
 			}
 
 			out := f.IOStreams.Out
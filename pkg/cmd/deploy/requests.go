@@ -11,6 +11,7 @@ import (
 	api "github.com/aziontech/azion-cli/pkg/api/edge_functions"
 	apipurge "github.com/aziontech/azion-cli/pkg/api/realtime_purge"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
 
 	"github.com/aziontech/azion-cli/pkg/contracts"
@@ -157,7 +158,7 @@ func (cmd *DeployCmd) createFunction(client *api.Client, ctx context.Context, co
 	response, err := client.Create(ctx, &reqCre)
 	if err != nil {
 		logger.Debug("Error while creating edge function", zap.Error(err))
-		return 0, fmt.Errorf(msg.ErrorCreateFunction.Error(), err)
+		return 0, utils.WrapError(msg.ErrorCreateFunction, err)
 	}
 	logger.FInfo(cmd.F.IOStreams.Out, fmt.Sprintf(msg.DeployOutputEdgeFunctionCreate, response.GetName(), response.GetId()))
 	return response.GetId(), nil
@@ -207,7 +208,7 @@ func (cmd *DeployCmd) updateFunction(client *api.Client, ctx context.Context, co
 	reqUpd.SetJsonArgs(args)
 	response, err := client.Update(ctx, &reqUpd)
 	if err != nil {
-		return 0, fmt.Errorf(msg.ErrorUpdateFunction.Error(), err)
+		return 0, utils.WrapError(msg.ErrorUpdateFunction, err)
 	}
 
 	logger.FInfo(cmd.F.IOStreams.Out, fmt.Sprintf(msg.DeployOutputEdgeFunctionUpdate, response.GetName(), conf.Function.Id))
@@ -224,7 +225,7 @@ func (cmd *DeployCmd) createApplication(client *apiapp.Client, ctx context.Conte
 	reqApp.SetDeliveryProtocol("http,https")
 	application, err := client.Create(ctx, &reqApp)
 	if err != nil {
-		return 0, 0, fmt.Errorf(msg.ErrorCreateApplication.Error(), err)
+		return 0, 0, utils.WrapError(msg.ErrorCreateApplication, err)
 	}
 	logger.FInfo(cmd.F.IOStreams.Out, fmt.Sprintf(msg.DeployOutputEdgeApplicationCreate, application.GetName(), application.GetId()))
 	reqUpApp := apiapp.UpdateRequest{}
@@ -234,7 +235,7 @@ func (cmd *DeployCmd) createApplication(client *apiapp.Client, ctx context.Conte
 	application, err = client.Update(ctx, &reqUpApp)
 	if err != nil {
 		logger.Debug("Error while setting up edge application", zap.Error(err))
-		return 0, 0, fmt.Errorf(msg.ErrorUpdateApplication.Error(), err)
+		return 0, 0, utils.WrapError(msg.ErrorUpdateApplication, err)
 	}
 	reqIns := apiapp.CreateInstanceRequest{}
 	reqIns.SetEdgeFunctionId(conf.Function.Id)
@@ -243,7 +244,7 @@ func (cmd *DeployCmd) createApplication(client *apiapp.Client, ctx context.Conte
 	instance, err := client.CreateInstancePublish(ctx, &reqIns)
 	if err != nil {
 		logger.Debug("Error while creating edge function instance", zap.Error(err))
-		return 0, 0, fmt.Errorf(msg.ErrorCreateInstance.Error(), err)
+		return 0, 0, utils.WrapError(msg.ErrorCreateInstance, err)
 	}
 	InstanceId = instance.GetId()
 	return application.GetId(), instance.GetId(), nil
@@ -259,7 +260,7 @@ func (cmd *DeployCmd) updateApplication(client *apiapp.Client, ctx context.Conte
 	reqApp.Id = conf.Application.Id
 	application, err := client.Update(ctx, &reqApp)
 	if err != nil {
-		return fmt.Errorf(msg.ErrorUpdateApplication.Error(), err)
+		return utils.WrapError(msg.ErrorUpdateApplication, err)
 	}
 	logger.FInfo(cmd.F.IOStreams.Out, fmt.Sprintf(msg.DeployOutputEdgeApplicationUpdate, application.GetName(), application.GetId()))
 	return nil
@@ -303,7 +304,7 @@ func (cmd *DeployCmd) createDomain(client *apidom.Client, ctx context.Context, c
 	reqDom.SetEdgeApplicationId(conf.Application.Id)
 	domain, err := client.Create(ctx, &reqDom)
 	if err != nil {
-		return nil, fmt.Errorf(msg.ErrorCreateDomain.Error(), err)
+		return nil, utils.WrapError(msg.ErrorCreateDomain, err)
 	}
 	logger.FInfo(cmd.F.IOStreams.Out, fmt.Sprintf(msg.DeployOutputDomainCreate, conf.Name, domain.GetId()))
 	return domain, nil
@@ -320,7 +321,7 @@ func (cmd *DeployCmd) updateDomain(client *apidom.Client, ctx context.Context, c
 	reqDom.Id = conf.Domain.Id
 	domain, err := client.Update(ctx, &reqDom)
 	if err != nil {
-		return nil, fmt.Errorf(msg.ErrorUpdateDomain.Error(), err)
+		return nil, utils.WrapError(msg.ErrorUpdateDomain, err)
 	}
 	logger.FInfo(cmd.F.IOStreams.Out, fmt.Sprintf(msg.DeployOutputDomainUpdate, conf.Name, domain.GetId()))
 	return domain, nil
@@ -2,6 +2,7 @@ package deploy
 
 import (
 	"os"
+	"strconv"
 	"strings"
 
 	msg "github.com/aziontech/azion-cli/messages/deploy"
@@ -36,7 +37,10 @@ func (cmd *DeployCmd) uploadFiles(f *cmdutil.Factory, pathStatic string, version
 
 	logger.FInfo(cmd.F.IOStreams.Out, msg.UploadStart)
 
-	noOfWorkers := 5
+	noOfWorkers, err := strconv.Atoi(cmd.F.Config.GetString("upload_concurrency"))
+	if err != nil || noOfWorkers <= 0 {
+		noOfWorkers = 5
+	}
 	var currentFile int64
 	jobs := make(chan contracts.FileOps, totalFiles)
 	results := make(chan error, noOfWorkers)
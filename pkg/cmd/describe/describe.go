@@ -3,9 +3,17 @@ package describe
 import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/describe"
+	cacheSettings "github.com/aziontech/azion-cli/pkg/cmd/cache_settings/describe"
 	"github.com/aziontech/azion-cli/pkg/cmd/describe/domains"
 	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/describe/edge_applications"
 	ruleEngine "github.com/aziontech/azion-cli/pkg/cmd/describe/rules_engine"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/device_groups/describe"
+	edgeFunctions "github.com/aziontech/azion-cli/pkg/cmd/edge_functions/describe"
+	functionInstances "github.com/aziontech/azion-cli/pkg/cmd/edge_functions_instances/describe"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/edge_services/describe"
+	serviceResources "github.com/aziontech/azion-cli/pkg/cmd/edge_services/resources/describe"
+	origins "github.com/aziontech/azion-cli/pkg/cmd/origins/describe"
+	variables "github.com/aziontech/azion-cli/pkg/cmd/variables/describe"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +25,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Long:  msg.LongDescription,
 		Example: heredoc.Doc(`
 		$ azion describe --help
+		$ azion describe edge-function --function-id 4312
 		$ azion describe edge-application
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -27,6 +36,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(edgeApplications.NewCmd(f))
 	cmd.AddCommand(ruleEngine.NewCmd(f))
 	cmd.AddCommand(domains.NewCmd(f))
+	cmd.AddCommand(origins.NewCmd(f))
+	cmd.AddCommand(cacheSettings.NewCmd(f))
+	cmd.AddCommand(edgeFunctions.NewCmd(f))
+	cmd.AddCommand(functionInstances.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(serviceResources.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
@@ -2,19 +2,15 @@ package domains
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/describe/domains"
-	"github.com/fatih/color"
 
 	api "github.com/aziontech/azion-cli/pkg/api/domains"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -32,6 +28,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
         $ azion describe domains --domain-id 4312
         $ azion describe domains --domain-id 1337 --out "./tmp/test.json" --format json
         $ azion describe domains --domain-id 1337 --format json
+        $ azion describe domains --domain-id 1337 --format csv
         `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if !cmd.Flags().Changed("domain-id") {
@@ -51,60 +48,34 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return fmt.Errorf(msg.ErrorGetDomain.Error(), err.Error())
 			}
 
-			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, domain)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
+				return err
 			}
 
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				logger.LogSuccess(out, fmt.Sprintf(msg.FileWritten, filepath.Clean(opts.OutPath)))
-				return nil
-			}
-
-			logger.FInfo(out, string(formattedFuction[:]))
-			return nil
+			return p.Describe(domain, fields(domain))
 		},
 	}
 
 	cmd.Flags().StringVar(&domainID, "domain-id", "", msg.FlagDomainID)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.FlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.FlagFormat)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, domain api.DomainResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+func fields(domain api.DomainResponse) []printer.Field {
+	fields := []printer.Field{
+		{Label: "ID", Value: domain.GetId()},
+		{Label: "Name", Value: domain.GetName()},
+		{Label: "Domain", Value: domain.GetDomainName()},
+		{Label: "Cname Access Only", Value: domain.GetCnameAccessOnly()},
 	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(domain, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-
-	tbl.AddRow("ID: ", domain.GetId())
-	tbl.AddRow("Name: ", domain.GetName())
-	tbl.AddRow("Domain: ", domain.GetDomainName())
-	tbl.AddRow("Cname Access Only: ", domain.GetCnameAccessOnly())
 	if domain.GetCnameAccessOnly() {
-		Cnames := domain.GetCnames()
-		tbl.AddRow("Cnames: ")
-		for _, cname := range Cnames {
-			tbl.AddRow("	", cname)
-		}
+		fields = append(fields, printer.Field{Label: "Cnames", Value: domain.GetCnames()})
 	}
-	tbl.AddRow("Application ID: ", domain.GetEdgeApplicationId())
-	tbl.AddRow("Digital Certificate ID: ", domain.GetDigitalCertificateId())
-
-	return tbl.GetByteFormat(), nil
+	return append(fields,
+		printer.Field{Label: "Application ID", Value: domain.GetEdgeApplicationId()},
+		printer.Field{Label: "Digital Certificate ID", Value: domain.GetDigitalCertificateId()},
+	)
 }
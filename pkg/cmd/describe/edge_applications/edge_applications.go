@@ -2,18 +2,15 @@ package edge_applications
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/describe/edge_applications"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
-	"path/filepath"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
@@ -29,6 +26,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
         $ azion describe edge-application --id 4312
         $ azion describe edge-application --id 1337 --out "./tmp/test.json"
         $ azion describe edge-application --id 1337 --format json
+        $ azion describe edge-application --id 1337 --format yaml
+        $ azion describe edge-application --id 1337 --template '{{.Name}}'
         `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 
@@ -46,68 +45,43 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			ctx := context.Background()
 			application, err := client.Get(ctx, applicationID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorGetApplication.Error(), err)
+				return utils.WrapError(msg.ErrorGetApplication, err)
 			}
 
-			out := f.IOStreams.Out
-			formattedApp, err := format(cmd, application)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
+				return err
 			}
 
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedApp, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedApp[:])
-				if err != nil {
-					return err
-				}
-			}
-
-			return nil
+			return p.Describe(application, fields(application))
 		},
 	}
 
 	cmd.Flags().StringVar(&applicationID, "id", "", msg.FlagId)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.FlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.FlagFormat)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, application api.EdgeApplicationResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+func fields(application api.EdgeApplicationResponse) []printer.Field {
+	return []printer.Field{
+		{Label: "ID", Value: application.GetId()},
+		{Label: "Name", Value: application.GetName()},
+		{Label: "Active", Value: application.GetActive()},
+		{Label: "Application Acceleration", Value: application.GetApplicationAcceleration()},
+		{Label: "Caching", Value: application.GetCaching()},
+		{Label: "Delivery Protocol", Value: application.GetDeliveryProtocol()},
+		{Label: "Device Detection", Value: application.GetDeviceDetection()},
+		{Label: "Edge Firewall", Value: application.GetEdgeFirewall()},
+		{Label: "Edge Functions", Value: application.GetEdgeFunctions()},
+		{Label: "Http Port", Value: application.GetHttpPort()},
+		{Label: "HttpsPort", Value: application.GetHttpsPort()},
+		{Label: "Image Optimization", Value: application.GetImageOptimization()},
+		{Label: "L2 Caching", Value: application.GetL2Caching()},
+		{Label: "Load Balancer", Value: application.GetLoadBalancer()},
+		{Label: "Minimum TLS Version", Value: application.GetMinimumTlsVersion()},
+		{Label: "Raw Logs", Value: application.GetRawLogs()},
+		{Label: "Web Application Firewall", Value: application.GetWebApplicationFirewall()},
 	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(application, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("ID: ", application.GetId())
-	tbl.AddRow("Name: ", application.GetName())
-	tbl.AddRow("Active: ", application.GetActive())
-	tbl.AddRow("Application Acceleration: ", application.GetApplicationAcceleration())
-	tbl.AddRow("Caching: ", application.GetCaching())
-	tbl.AddRow("Delivery Protocol: ", application.GetDeliveryProtocol())
-	tbl.AddRow("Device Detection: ", application.GetDeviceDetection())
-	tbl.AddRow("Edge Firewall: ", application.GetEdgeFirewall())
-	tbl.AddRow("Edge Functions: ", application.GetEdgeFunctions())
-	tbl.AddRow("Http Port: ", application.GetHttpPort())
-	tbl.AddRow("HttpsPort: ", application.GetHttpsPort())
-	tbl.AddRow("Image Optimization: ", application.GetImageOptimization())
-	tbl.AddRow("L2 Caching: ", application.GetL2Caching())
-	tbl.AddRow("Load Balancer: ", application.GetLoadBalancer())
-	tbl.AddRow("Minimum TLS Version: ", application.GetMinimumTlsVersion())
-	tbl.AddRow("Raw Logs: ", application.GetRawLogs())
-	tbl.AddRow("Web Application Firewall: ", application.GetWebApplicationFirewall())
-	return tbl.GetByteFormat(), nil
 }
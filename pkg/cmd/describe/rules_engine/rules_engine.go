@@ -2,22 +2,19 @@ package rulesengine
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"path/filepath"
 	"strconv"
 
-	"github.com/fatih/color"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/describe/rules_engine"
 
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -40,6 +37,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
       $ azion describe rules-engine  --application-id 1673635839 --rule-id 31223 --phase request
       $ azion describe rules-engine --application-id 1673635839 --rule-id 31223 --phase response --format json
       $ azion describe rules-engine --application-id 1673635839 --rule-id 31223 --phase request --out "./tmp/test.json"
+      $ azion describe rules-engine --application-id 1673635839 --rule-id 31223 --phase request --format yaml
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("rule-id") {
@@ -88,83 +86,55 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			ctx := context.Background()
 			rules, err := client.GetRulesEngine(ctx, applicationID, ruleID, phase)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorGetRulesEngine.Error(), err)
+				return utils.WrapError(msg.ErrorGetRulesEngine, err)
 			}
 
-			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, rules)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
+				return err
 			}
 
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.FileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
-				if err != nil {
-					return err
-				}
-			}
-
-			return nil
+			return p.Describe(rules, fields(rules))
 		},
 	}
 
 	cmd.Flags().Int64Var(&applicationID, "application-id", 0, msg.FlagAppID)
 	cmd.Flags().Int64Var(&ruleID, "rule-id", 0, msg.FlagRuleID)
 	cmd.Flags().StringVar(&phase, "phase", "request", msg.FlagPhase)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.DescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.DescribeFlagFormat)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, rules api.RulesEngineResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(rules, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Rules Engine ID: ", rules.GetId())
-	tbl.AddRow("Name: ", rules.GetName())
-	tbl.AddRow("Description: ", rules.GetDescription())
-	tbl.AddRow("Order: ", rules.GetOrder())
-	tbl.AddRow("Active: ", rules.GetIsActive())
-	tbl.AddRow("")
-	tbl.AddRow("Behaviours: ")
+// fields shows each behavior and criterion on one line: behaviors as "name target" and criteria
+// as "conditional variable operator input value"
+func fields(rules api.RulesEngineResponse) []printer.Field {
+	behaviors := []string{}
 	for _, b := range rules.GetBehaviors() {
 		if b.RulesEngineBehaviorString != nil {
-			tbl.AddRow("  Name: ", b.RulesEngineBehaviorString.GetName())
-			tbl.AddRow("  Target: ", b.RulesEngineBehaviorString.GetTarget())
-		} else {
-			tbl.AddRow("  Name: ", b.RulesEngineBehaviorObject.GetName())
-			tbl.AddRow("  Target: ")
-			tbl.AddRow("     Captured Array: ", b.RulesEngineBehaviorObject.Target.GetCapturedArray())
-			tbl.AddRow("     Regex: ", b.RulesEngineBehaviorObject.Target.GetRegex())
-			tbl.AddRow("     Subject: ", b.RulesEngineBehaviorObject.Target.GetSubject())
+			behaviors = append(behaviors, fmt.Sprintf("%s %s", b.RulesEngineBehaviorString.GetName(), b.RulesEngineBehaviorString.GetTarget()))
+			continue
 		}
-		tbl.AddRow("")
+		target := b.RulesEngineBehaviorObject.Target
+		behaviors = append(behaviors, fmt.Sprintf("%s captured_array=%s regex=%s subject=%s",
+			b.RulesEngineBehaviorObject.GetName(), target.GetCapturedArray(), target.GetRegex(), target.GetSubject()))
 	}
-	tbl.AddRow("Criteria: ")
+
+	criteria := []string{}
 	for _, c := range rules.GetCriteria() {
 		for _, c2 := range c {
-			tbl.AddRow("  Conditional: ", c2.GetConditional())
-			tbl.AddRow("  Variable: ", c2.GetVariable())
-			tbl.AddRow("  Operator: ", c2.GetOperator())
-			tbl.AddRow("  Input Value: ", c2.GetInputValue())
-			tbl.AddRow("")
+			criteria = append(criteria, fmt.Sprintf("%s %s %s %s", c2.GetConditional(), c2.GetVariable(), c2.GetOperator(), c2.GetInputValue()))
 		}
 	}
-	return tbl.GetByteFormat(), nil
+
+	return []printer.Field{
+		{Label: "Rules Engine ID", Value: rules.GetId()},
+		{Label: "Name", Value: rules.GetName()},
+		{Label: "Description", Value: rules.GetDescription()},
+		{Label: "Order", Value: rules.GetOrder()},
+		{Label: "Active", Value: rules.GetIsActive()},
+		{Label: "Behaviours", Value: behaviors},
+		{Label: "Criteria", Value: criteria},
+	}
 }
@@ -1,12 +1,18 @@
 package dev
 
 import (
+	"context"
 	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"sync"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/dev"
 	"github.com/aziontech/azion-cli/pkg/cmd/build"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/iostreams"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/utils"
@@ -14,21 +20,44 @@ import (
 	"go.uber.org/zap"
 )
 
+var (
+	Port          int
+	Host          string
+	Path          string
+	Watch         bool
+	EnvFile       string
+	SyncVariables bool
+)
+
 type DevCmd struct {
 	Io                    *iostreams.IOStreams
 	CommandRunnerStream   func(out io.Writer, cmd string, envvars []string) error
-	CommandRunInteractive func(f *cmdutil.Factory, comm string) error
+	CommandRunInteractive func(f *cmdutil.Factory, comm string, envVars []string) error
 	BuildCmd              func(f *cmdutil.Factory) *build.BuildCmd
+	GetAzionJsonContent   func() (*contracts.AzionApplicationOptions, error)
+	Serve                 func(ctx context.Context, addr string, handler http.Handler) error
+	EnvLoader             func(path string) ([]string, error)
+	FileReader            func(path string) ([]byte, error)
+	WriteFile             func(filename string, data []byte, perm fs.FileMode) error
+	Stat                  func(path string) (fs.FileInfo, error)
+	AskSecret             func(key string) (string, error)
 	F                     *cmdutil.Factory
 }
 
 func NewDevCmd(f *cmdutil.Factory) *DevCmd {
 	return &DevCmd{
-		F:        f,
-		Io:       f.IOStreams,
-		BuildCmd: build.NewBuildCmd,
-		CommandRunInteractive: func(f *cmdutil.Factory, comm string) error {
-			return utils.CommandRunInteractive(f, comm)
+		F:                   f,
+		Io:                  f.IOStreams,
+		BuildCmd:            build.NewBuildCmd,
+		GetAzionJsonContent: utils.GetAzionJsonContent,
+		Serve:               listenAndServe,
+		EnvLoader:           utils.LoadEnvVarsFromFile,
+		FileReader:          os.ReadFile,
+		WriteFile:           os.WriteFile,
+		Stat:                os.Stat,
+		AskSecret:           askSecret,
+		CommandRunInteractive: func(f *cmdutil.Factory, comm string, envVars []string) error {
+			return utils.CommandRunInteractiveWithEnv(f, comm, envVars)
 		},
 	}
 }
@@ -43,12 +72,22 @@ func NewCobraCmd(dev *DevCmd) *cobra.Command {
 		Example: heredoc.Doc(`       
         $ azion dev
         $ azion dev --help
+        $ azion dev --port 8080 --host 0.0.0.0
+        $ azion dev --watch
+        $ azion dev --env-file ./azion/dev.env
+        $ azion dev --sync-variables
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return dev.Run(dev.F)
 		},
 	}
 	devCmd.Flags().BoolP("help", "h", false, msg.DevFlagHelp)
+	devCmd.Flags().IntVar(&Port, "port", 3000, msg.DevFlagPort)
+	devCmd.Flags().StringVar(&Host, "host", "localhost", msg.DevFlagHost)
+	devCmd.Flags().StringVar(&Path, "path", "", msg.DevFlagPath)
+	devCmd.Flags().BoolVar(&Watch, "watch", false, msg.DevFlagWatch)
+	devCmd.Flags().StringVar(&EnvFile, "env-file", "", msg.DevFlagEnvFile)
+	devCmd.Flags().BoolVar(&SyncVariables, "sync-variables", false, msg.DevFlagSyncVariables)
 	return devCmd
 }
 
@@ -67,10 +106,53 @@ func (cmd *DevCmd) Run(f *cmdutil.Factory) error {
 		return err
 	}
 
-	err = vulcan(f, cmd)
+	conf, err := cmd.GetAzionJsonContent()
+	if err != nil {
+		return err
+	}
+
+	// the server, Vulcan and the watcher stop together when the user interrupts the command
+	ctx, stop := utils.NotifyInterrupt(context.Background())
+	defer stop()
+
+	var watching sync.WaitGroup
+	if Watch {
+		watching.Add(1)
+		go func() {
+			defer watching.Done()
+			err := build.RunWatch(ctx, ".")
+			if err != nil {
+				logger.Error(err.Error())
+			}
+		}()
+	}
+
+	err = cmd.run(ctx, f, conf)
+	stop()
+	watching.Wait()
+	return err
+}
+
+func (cmd *DevCmd) run(ctx context.Context, f *cmdutil.Factory, conf *contracts.AzionApplicationOptions) error {
+	// static projects are served by the built-in server, so there is no need to install Vulcan
+	static := conf.Template == "static" || conf.Template == "simple"
+	if static && (EnvFile != "" || SyncVariables) {
+		return utils.NewError(utils.CodeValidation, msg.ErrorStaticVariables)
+	}
+
+	if SyncVariables {
+		if err := cmd.syncVariables(); err != nil {
+			return err
+		}
+	}
+
+	envs, err := cmd.loadVariables()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if static {
+		return serve(ctx, cmd, conf)
+	}
+	return vulcan(ctx, f, cmd, envs)
 }
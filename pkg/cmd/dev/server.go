@@ -0,0 +1,145 @@
+package dev
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/dev"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// staticServer serves the files of a static project the same way the edge function
+// created by 'azion deploy' does, proxying the paths not found locally to the origin
+type staticServer struct {
+	root  string
+	proxy *httputil.ReverseProxy
+}
+
+func newStaticServer(root string, origin string) (*staticServer, error) {
+	server := &staticServer{root: root}
+
+	if origin == "" {
+		return server, nil
+	}
+
+	if !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+		origin = "https://" + origin
+	}
+
+	target, err := url.Parse(origin)
+	if err != nil {
+		logger.Debug("Error while parsing origin address", zap.Error(err))
+		return nil, fmt.Errorf(msg.ErrorInvalidOrigin.Error(), origin)
+	}
+
+	proxy := httputil.NewSingleHostReverseProxy(target)
+	director := proxy.Director
+	proxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = target.Host
+	}
+	server.proxy = proxy
+
+	return server, nil
+}
+
+// assetPath mirrors the index.html and trailing slash resolution of the static edge function
+func assetPath(requestPath string) string {
+	if requestPath == "/" {
+		return "/index.html"
+	}
+	if strings.HasSuffix(requestPath, "/") {
+		return requestPath + "index.html"
+	}
+	return requestPath
+}
+
+func (s *staticServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	asset := path.Clean("/" + assetPath(r.URL.Path))
+	filePath := filepath.Join(s.root, filepath.FromSlash(asset))
+
+	file, err := os.Open(filePath)
+	if err == nil {
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err == nil && !info.IsDir() {
+			logger.Debug("Serving local file", zap.String("path", filePath))
+			http.ServeContent(w, r, info.Name(), info.ModTime(), file)
+			return
+		}
+	}
+
+	if s.proxy != nil {
+		logger.Debug("Proxying request to origin", zap.String("path", r.URL.Path))
+		s.proxy.ServeHTTP(w, r)
+		return
+	}
+
+	http.NotFound(w, r)
+}
+
+// staticPath returns the directory served locally, which is the same one uploaded by 'azion deploy'
+func staticPath(conf *contracts.AzionApplicationOptions) string {
+	if Path != "" {
+		return strings.Replace(Path, "./", "", -1)
+	}
+	if conf.Template == "static" {
+		return "dist"
+	}
+	return "."
+}
+
+func serve(ctx context.Context, cmd *DevCmd, conf *contracts.AzionApplicationOptions) error {
+	var origin string
+	if len(conf.Origin.Address) > 0 {
+		origin = conf.Origin.Address[0]
+	}
+
+	root := staticPath(conf)
+	server, err := newStaticServer(root, origin)
+	if err != nil {
+		return err
+	}
+
+	addr := fmt.Sprintf("%s:%d", Host, Port)
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.DevServerRunning, root, addr))
+	if origin != "" {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.DevServerProxy, origin))
+	}
+
+	err = cmd.Serve(ctx, addr, server)
+	if err != nil {
+		logger.Debug("Error while running local development server", zap.Error(err))
+		return msg.ErrorServer
+	}
+
+	return nil
+}
+
+// listenAndServe runs the server at addr until ctx is done
+func listenAndServe(ctx context.Context, addr string, handler http.Handler) error {
+	server := &http.Server{Addr: addr, Handler: handler}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+		logger.Debug("Stopping local development server")
+		return server.Shutdown(context.Background())
+	}
+}
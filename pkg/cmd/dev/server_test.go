@@ -0,0 +1,89 @@
+package dev
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestStaticServer(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	root := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(root, "docs"), os.ModePerm)
+	_ = os.WriteFile(filepath.Join(root, "index.html"), []byte("home"), 0644)
+	_ = os.WriteFile(filepath.Join(root, "docs", "index.html"), []byte("docs"), 0644)
+	_ = os.WriteFile(filepath.Join(root, "style.css"), []byte("body{}"), 0644)
+
+	origin := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "origin "+r.URL.Path)
+	}))
+	defer origin.Close()
+
+	tests := []struct {
+		name   string
+		origin string
+		path   string
+		status int
+		body   string
+	}{
+		{name: "root serves index.html", path: "/", status: http.StatusOK, body: "home"},
+		{name: "trailing slash serves index.html", path: "/docs/", status: http.StatusOK, body: "docs"},
+		{name: "file is served as is", path: "/style.css", status: http.StatusOK, body: "body{}"},
+		{name: "directory without slash is not resolved", path: "/docs", status: http.StatusNotFound},
+		{name: "path outside root is not served", path: "/../../etc/passwd", status: http.StatusNotFound},
+		{name: "not found without origin", path: "/missing", status: http.StatusNotFound},
+		{name: "not found is proxied to origin", origin: origin.URL, path: "/missing", status: http.StatusOK, body: "origin /missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := newStaticServer(root, tt.origin)
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+
+			require.Equal(t, tt.status, rec.Code)
+			if tt.body != "" {
+				require.Equal(t, tt.body, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestListenAndServe(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+		go func() {
+			done <- listenAndServe(ctx, "127.0.0.1:0", http.NotFoundHandler())
+		}()
+
+		cancel()
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("expected the server to stop")
+		}
+	})
+
+	t.Run("address in use", func(t *testing.T) {
+		busy := httptest.NewServer(http.NotFoundHandler())
+		defer busy.Close()
+
+		err := listenAndServe(context.Background(), busy.Listener.Addr().String(), http.NotFoundHandler())
+		require.Error(t, err)
+	})
+}
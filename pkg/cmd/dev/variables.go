@@ -0,0 +1,123 @@
+package dev
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	msg "github.com/aziontech/azion-cli/messages/dev"
+	api "github.com/aziontech/azion-cli/pkg/api/variables"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"go.uber.org/zap"
+)
+
+// VariablesCachePath is the local copy of the edge variables, kept out of version control
+const VariablesCachePath = "azion/.variables.env"
+
+const gitignorePath = ".gitignore"
+
+// loadVariables returns the edge variables to be injected into the dev process.
+// Variables from --env-file take precedence over the ones in the local cache
+func (cmd *DevCmd) loadVariables() ([]string, error) {
+	var envs []string
+
+	if _, err := cmd.Stat(VariablesCachePath); err == nil {
+		cached, err := cmd.EnvLoader(VariablesCachePath)
+		if err != nil {
+			logger.Debug("Error while loading edge variables cache", zap.Error(err))
+			return nil, fmt.Errorf(msg.ErrorLoadingEnvFile.Error(), VariablesCachePath)
+		}
+		envs = append(envs, cached...)
+	}
+
+	if EnvFile != "" {
+		fileEnvs, err := cmd.EnvLoader(EnvFile)
+		if err != nil {
+			logger.Debug("Error while loading environment variables file", zap.Error(err))
+			return nil, fmt.Errorf(msg.ErrorLoadingEnvFile.Error(), EnvFile)
+		}
+		envs = append(envs, fileEnvs...)
+	}
+
+	logger.Debug("Edge variables loaded", zap.Strings("envs", utils.RedactEnvVars(envs)))
+	return envs, nil
+}
+
+// syncVariables fetches the edge variables of the account and writes them to the local cache.
+// The values of secret variables are never downloaded; they are asked for or left blank
+func (cmd *DevCmd) syncVariables() error {
+	logger.FInfo(cmd.Io.Out, msg.DevSyncingVariables)
+
+	client := api.NewClient(cmd.F.HttpClient, cmd.F.Config.GetString("api_url"), cmd.F.Config.GetString("token"))
+	variables, err := client.List(context.Background())
+	if err != nil {
+		return fmt.Errorf(msg.ErrorSyncVariables.Error(), err.Error())
+	}
+
+	var content strings.Builder
+	content.WriteString(msg.DevVariablesCacheHeader)
+	for _, variable := range variables {
+		value := variable.GetValue()
+		if variable.GetSecret() {
+			value = ""
+			if !cmd.F.GlobalFlagAll && cmd.F.CanPrompt() {
+				value, err = cmd.AskSecret(variable.GetKey())
+				if err != nil {
+					return err
+				}
+			}
+		}
+		fmt.Fprintf(&content, "%s=%s\n", variable.GetKey(), value)
+	}
+
+	err = cmd.WriteFile(VariablesCachePath, []byte(content.String()), 0600)
+	if err != nil {
+		logger.Debug("Error while writing edge variables cache", zap.Error(err))
+		return fmt.Errorf(utils.ErrorCreateFile.Error(), VariablesCachePath)
+	}
+
+	if err := cmd.ignoreVariablesCache(); err != nil {
+		return err
+	}
+
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.DevVariablesSynced, len(variables), VariablesCachePath))
+	return nil
+}
+
+// ignoreVariablesCache makes sure the cache file is listed in the project's .gitignore
+func (cmd *DevCmd) ignoreVariablesCache() error {
+	data, err := cmd.FileReader(gitignorePath)
+	if err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			if strings.TrimSpace(line) == VariablesCachePath {
+				return nil
+			}
+		}
+		if len(data) > 0 && !strings.HasSuffix(string(data), "\n") {
+			data = append(data, '\n')
+		}
+	}
+
+	data = append(data, []byte(VariablesCachePath+"\n")...)
+	err = cmd.WriteFile(gitignorePath, data, 0644)
+	if err != nil {
+		logger.Debug("Error while writing .gitignore file", zap.Error(err))
+		return fmt.Errorf(utils.ErrorCreateFile.Error(), gitignorePath)
+	}
+
+	return nil
+}
+
+func askSecret(key string) (string, error) {
+	var value string
+	prompt := &survey.Password{
+		Message: fmt.Sprintf(msg.DevAskSecretVariable, key),
+	}
+	err := survey.AskOne(prompt, &value)
+	if err != nil {
+		return "", err
+	}
+	return value, nil
+}
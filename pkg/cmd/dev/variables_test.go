@@ -0,0 +1,138 @@
+package dev
+
+import (
+	"context"
+	"io/fs"
+	"net/http"
+	"os"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/dev"
+	"github.com/aziontech/azion-cli/pkg/cmd/build"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const variablesResponse = `[
+  {"uuid": "32e8ffca-4021-49a4-971f-330935566af4", "key": "API_URL", "value": "https://example.com", "secret": false},
+  {"uuid": "e314a185-d775-40f9-9b68-714bbbfbd442", "key": "API_KEY", "value": "********", "secret": true}
+]`
+
+func TestSyncVariables(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	tests := []struct {
+		name      string
+		yes       bool
+		gitignore string
+		want      string
+		wantIgn   string
+	}{
+		{
+			name:      "secret is asked for",
+			gitignore: "node_modules",
+			want:      "API_URL=https://example.com\nAPI_KEY=typed\n",
+			wantIgn:   "node_modules\n" + VariablesCachePath + "\n",
+		},
+		{
+			name:      "secret is left blank with --yes",
+			yes:       true,
+			gitignore: VariablesCachePath + "\n",
+			want:      "API_URL=https://example.com\nAPI_KEY=\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &httpmock.Registry{}
+			mock.Register(
+				httpmock.REST(http.MethodGet, "variables"),
+				httpmock.JSONFromString(variablesResponse),
+			)
+
+			f, _, _ := testutils.NewFactory(mock)
+			f.GlobalFlagAll = tt.yes
+			f.IOStreams.SetStdinTTY(true)
+
+			written := map[string]string{}
+			cmd := NewDevCmd(f)
+			cmd.AskSecret = func(key string) (string, error) {
+				return "typed", nil
+			}
+			cmd.FileReader = func(path string) ([]byte, error) {
+				return []byte(tt.gitignore), nil
+			}
+			cmd.WriteFile = func(filename string, data []byte, perm fs.FileMode) error {
+				written[filename] = string(data)
+				return nil
+			}
+
+			err := cmd.syncVariables()
+			require.NoError(t, err)
+			require.Contains(t, written[VariablesCachePath], tt.want)
+
+			ignore, ok := written[gitignorePath]
+			if tt.wantIgn == "" {
+				require.False(t, ok)
+			} else {
+				require.Equal(t, tt.wantIgn, ignore)
+			}
+		})
+	}
+}
+
+func TestLoadVariables(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	f, _, _ := testutils.NewFactory(nil)
+	cmd := NewDevCmd(f)
+	cmd.Stat = func(path string) (fs.FileInfo, error) {
+		return nil, os.ErrNotExist
+	}
+	cmd.EnvLoader = func(path string) ([]string, error) {
+		return []string{"FROM=" + path}, nil
+	}
+
+	EnvFile = "dev.env"
+	defer func() { EnvFile = "" }()
+
+	envs, err := cmd.loadVariables()
+	require.NoError(t, err)
+	require.Equal(t, []string{"FROM=dev.env"}, envs)
+}
+
+func TestStaticVariables(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	azionJson := func() (*contracts.AzionApplicationOptions, error) {
+		return &contracts.AzionApplicationOptions{Template: "simple"}, nil
+	}
+
+	f, _, _ := testutils.NewFactory(nil)
+	cmd := NewDevCmd(f)
+	cmd.GetAzionJsonContent = azionJson
+	cmd.BuildCmd = func(f *cmdutil.Factory) *build.BuildCmd {
+		b := build.NewBuildCmd(f)
+		b.GetAzionJsonContent = azionJson
+		b.FileReader = func(path string) ([]byte, error) {
+			return []byte("{}"), nil
+		}
+		return b
+	}
+	cmd.Serve = func(ctx context.Context, addr string, handler http.Handler) error {
+		t.Fatal("static projects with edge variables should not be served")
+		return nil
+	}
+
+	EnvFile = "dev.env"
+	defer func() { EnvFile = "" }()
+
+	err := cmd.Run(f)
+	require.ErrorIs(t, err, msg.ErrorStaticVariables)
+	require.Equal(t, utils.ExitValidation, utils.ExitCode(err))
+}
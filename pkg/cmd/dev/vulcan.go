@@ -1,6 +1,7 @@
 package dev
 
 import (
+	"context"
 	"fmt"
 
 	msg "github.com/aziontech/azion-cli/messages/dev"
@@ -10,22 +11,23 @@ import (
 	"go.uber.org/zap"
 )
 
-func vulcan(f *cmdutil.Factory, cmd *DevCmd) error {
+func vulcan(ctx context.Context, f *cmdutil.Factory, cmd *DevCmd, envs []string) error {
 	command := vul.Command("", "dev")
 
-	err := runCommand(f, cmd, command)
-	if err != nil {
+	err := runCommand(f, cmd, command, envs)
+	// Vulcan receives the same interrupt as the CLI, so exiting because of it is not a failure
+	if err != nil && ctx.Err() == nil {
 		return fmt.Errorf(msg.ErrorVulcanExecute.Error(), err.Error())
 	}
 
 	return nil
 }
 
-func runCommand(f *cmdutil.Factory, cmd *DevCmd, command string) error {
+func runCommand(f *cmdutil.Factory, cmd *DevCmd, command string, envs []string) error {
 	logger.Debug("Running vulcan run command")
 	logger.Debug(fmt.Sprintf("$ %s\n", command))
 
-	err := cmd.CommandRunInteractive(f, command)
+	err := cmd.CommandRunInteractive(f, command, envs)
 	if err != nil {
 		logger.Debug("Error while running command with simultaneous output", zap.Error(err))
 		return msg.ErrFailedToRunDevCommand
@@ -32,9 +32,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion device_groups create --application-id 1673635839 --name "asdf" --user-agent "httpbin.org"
-        $ azion device_groups create -a 1673635839 --name "asdf" --user-agent "httpbin.org"
-        $ azion device_groups create -a 1673635839 --in "create.json"
+        $ azion create device-group --application-id 1673635839 --name "asdf" --user-agent "httpbin.org"
+        $ azion create device-group -a 1673635839 --name "asdf" --user-agent "httpbin.org"
+        $ azion create device-group -a 1673635839 --in "create.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			request := api.CreateDeviceGroupsRequest{}
@@ -68,7 +68,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.CreateDeviceGroups(context.Background(), &request, fields.ApplicationID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreateDeviceGroups.Error(), err)
+				return utils.WrapError(msg.ErrorCreateDeviceGroups, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.DeviceGroupsCreateOutputSuccess, response.GetId())
 			return nil
@@ -80,6 +80,6 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Name, "name", "", msg.DeviceGroupsCreateFlagName)
 	flags.StringVar(&fields.UserAgent, "user-agent", "", msg.DeviceGroupsCreateFlagUserAgent)
 	flags.StringVar(&fields.Path, "in", "", msg.DeviceGroupsCreateFlagIn)
-	flags.BoolP("help", "h", false, msg.DeviceGroupsFlagHelp)
+	flags.BoolP("help", "h", false, msg.DeviceGroupsCreateHelpFlag)
 	return cmd
 }
@@ -8,6 +8,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/device_groups"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -21,8 +22,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		  $ azion device_groups delete --application-id 1234 --group-id 12312
-		  $ azion device_groups delete -a 1234 -g 12312
+		  $ azion delete device-group --application-id 1234 --group-id 12312
+		  $ azion delete device-group -a 1234 -g 12312
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("group-id") {
@@ -34,7 +35,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			err := client.DeleteDeviceGroup(ctx, applicationID, groupID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDelete.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDelete, err)
 			}
 
 			fmt.Fprintf(f.IOStreams.Out, msg.DeviceGroupsDeleteOutputSuccess, groupID)
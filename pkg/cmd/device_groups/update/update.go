@@ -34,9 +34,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion device_groups update --application-id 1673635839 --group-id 12312 --user-agent "(Mobile|iP(hone|od)|BlackBerry|IEMobile)"
-        $ azion device_groups update -a 1673635839 -g 12312 --name "updated name"
-        $ azion device_groups update -a 1673635839 -g 12312 --in "update.json"
+        $ azion update device-group --application-id 1673635839 --group-id 12312 --user-agent "(Mobile|iP(hone|od)|BlackBerry|IEMobile)"
+        $ azion update device-group -a 1673635839 -g 12312 --name "updated name"
+        $ azion update device-group -a 1673635839 -g 12312 --in "update.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if cmd.Flags().Changed("in") && (!cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("group-id")) {
@@ -78,7 +78,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.UpdateDeviceGroup(context.Background(), request, fields.ApplicationID, fields.GroupID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorUpdateDeviceGroups.Error(), err)
+				return utils.WrapError(msg.ErrorUpdateDeviceGroups, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.DeviceGroupsUpdateOutputSuccess, response.GetId())
 			return nil
@@ -91,6 +91,6 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.StringVar(&fields.Name, "name", "", msg.DeviceGroupsUpdateFlagName)
 	flags.StringVar(&fields.UserAgent, "user-agent", "", msg.DeviceGroupsUpdateFlagUserAgent)
 	flags.StringVar(&fields.Path, "in", "", msg.DeviceGroupsUpdateFlagIn)
-	flags.BoolP("help", "h", false, msg.DeviceGroupsFlagHelp)
+	flags.BoolP("help", "h", false, msg.DeviceGroupsUpdateHelpFlag)
 	return cmd
 }
@@ -0,0 +1,215 @@
+package diff
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/diff"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/iostreams"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/manifest"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type DiffCmd struct {
+	Io                  *iostreams.IOStreams
+	F                   *cmdutil.Factory
+	ReadManifest        func(path string) (*manifest.Manifest, error)
+	GetAzionJsonContent func() (*contracts.AzionApplicationOptions, error)
+}
+
+// result is the output of the command with --format json or yaml
+type result struct {
+	EdgeApplication string            `json:"edge_application"`
+	Drift           bool              `json:"drift"`
+	Changes         []manifest.Change `json:"changes"`
+}
+
+func NewDiffCmd(f *cmdutil.Factory) *DiffCmd {
+	return &DiffCmd{
+		Io:                  f.IOStreams,
+		F:                   f,
+		ReadManifest:        manifest.Read,
+		GetAzionJsonContent: utils.GetAzionJsonContent,
+	}
+}
+
+func NewCobraCmd(diff *DiffCmd) *cobra.Command {
+	var file string
+	output := &contracts.OutputOptions{}
+
+	cobraCmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ShortDescription,
+		Long:          msg.LongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion diff
+		$ azion diff --file stack.yaml
+		$ azion diff -f stack.json --format json
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return diff.Run(file, output)
+		},
+	}
+
+	cobraCmd.Flags().StringVarP(&file, "file", "f", "", msg.FlagFile)
+	cmdutil.AddOutputFlags(cobraCmd, output)
+	cobraCmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cobraCmd
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	return NewCobraCmd(NewDiffCmd(f))
+}
+
+func (cmd *DiffCmd) Run(path string, output *contracts.OutputOptions) error {
+	logger.Debug("Running diff command")
+
+	desired, source, err := cmd.desired(path)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	clients := manifest.NewClients(cmd.F.HttpClient, cmd.F.Config.GetString("api_url"), cmd.F.Config.GetString("token"))
+	appID, err := clients.FindApplication(ctx, &desired.EdgeApplication)
+	if err != nil {
+		return utils.WrapError(msg.ErrorDiff, err)
+	}
+	var live *manifest.Manifest
+	if appID != 0 {
+		live, err = clients.Fetch(ctx, appID)
+		if err != nil {
+			// an edge application deleted from the account is a difference and not an error
+			if utils.AsError(err).Code != utils.CodeNotFound {
+				return err
+			}
+			logger.Debug("The edge application of the manifest doesn't exist in the account")
+		}
+	}
+	if path == "" && live != nil {
+		recordedOnly(desired, live)
+	}
+
+	plan := manifest.NewPlan(desired, live)
+	p, err := cmdutil.NewPrinter(cmd.F, output)
+	if err != nil {
+		return err
+	}
+	if p.DefaultTable() {
+		err = p.Text(text(desired, source, plan))
+	} else {
+		err = p.Describe(newResult(desired, plan), fields(desired, plan))
+	}
+	if err != nil {
+		return err
+	}
+	if plan.Empty() {
+		return nil
+	}
+	return utils.NewError(utils.CodeDrift, fmt.Errorf(msg.ErrorDrift.Error(), source, len(plan.Changes)))
+}
+
+// desired returns the manifest to compare and how the output refers to it: the manifest file or,
+// when there is none, the resources deploy recorded in azion.json
+func (cmd *DiffCmd) desired(path string) (*manifest.Manifest, string, error) {
+	if path != "" {
+		m, err := cmd.ReadManifest(path)
+		if err != nil {
+			return nil, "", utils.NewError(utils.CodeValidation, err)
+		}
+		return m, path, nil
+	}
+
+	conf, err := cmd.GetAzionJsonContent()
+	if err != nil {
+		return nil, "", utils.NewError(utils.CodeValidation, err)
+	}
+	if conf.Application.Id == 0 {
+		return nil, "", utils.NewError(utils.CodeValidation, msg.ErrorNotDeployed)
+	}
+
+	app := manifest.Application{ID: conf.Application.Id, Name: conf.Application.Name}
+	if conf.Origin.Id != 0 {
+		app.Origins = []manifest.Origin{{ID: conf.Origin.Id, Name: conf.Origin.Name, Addresses: conf.Origin.Address}}
+	}
+	if conf.Domain.Id != 0 {
+		app.Domains = []manifest.Domain{{ID: conf.Domain.Id, Name: conf.Domain.Name}}
+	}
+	return &manifest.Manifest{EdgeApplication: app}, msg.AzionJson, nil
+}
+
+// recordedOnly keeps in live the resources azion.json records, since it doesn't list the others
+// of the edge application. The recorded origin is matched by its ID, which live has with its key
+func recordedOnly(desired, live *manifest.Manifest) {
+	want, have := &desired.EdgeApplication, &live.EdgeApplication
+
+	var origins []manifest.Origin
+	for _, o := range have.Origins {
+		for i := range want.Origins {
+			if want.Origins[i].ID == o.ID {
+				want.Origins[i].Key = o.Key
+				origins = append(origins, o)
+			}
+		}
+	}
+	var domains []manifest.Domain
+	for _, d := range have.Domains {
+		for _, w := range want.Domains {
+			if w.ID == d.ID {
+				domains = append(domains, d)
+			}
+		}
+	}
+
+	*have = manifest.Application{
+		ID:      have.ID,
+		Name:    have.Name,
+		Origins: origins,
+		Domains: domains,
+	}
+}
+
+func text(desired *manifest.Manifest, source string, plan *manifest.Plan) []byte {
+	var b bytes.Buffer
+	if plan.Empty() {
+		fmt.Fprintf(&b, msg.NoDiff, source)
+		return b.Bytes()
+	}
+	fmt.Fprintf(&b, msg.DiffHeader, desired.EdgeApplication.Name)
+	plan.Print(&b)
+	create, update, del := plan.Count()
+	fmt.Fprintf(&b, msg.DiffSummary, create, update, del)
+	return b.Bytes()
+}
+
+func newResult(desired *manifest.Manifest, plan *manifest.Plan) result {
+	changes := plan.Changes
+	if changes == nil {
+		changes = []manifest.Change{}
+	}
+	return result{
+		EdgeApplication: desired.EdgeApplication.Name,
+		Drift:           !plan.Empty(),
+		Changes:         changes,
+	}
+}
+
+func fields(desired *manifest.Manifest, plan *manifest.Plan) []printer.Field {
+	create, update, del := plan.Count()
+	return []printer.Field{
+		{Label: "Edge application", Value: desired.EdgeApplication.Name},
+		{Label: "Drift", Value: !plan.Empty()},
+		{Label: "Only in the manifest", Value: create},
+		{Label: "Changed", Value: update},
+		{Label: "Only in the account", Value: del},
+	}
+}
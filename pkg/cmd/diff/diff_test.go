@@ -0,0 +1,147 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func registerLive(mock *httpmock.Registry) {
+	testutils.RegisterEdgeApplication(mock, nil)
+	mock.Register(httpmock.REST("GET", "domains"), httpmock.JSONFromFile("./fixtures/domains.json"))
+}
+
+func azionJson(address string) func() (*contracts.AzionApplicationOptions, error) {
+	return func() (*contracts.AzionApplicationOptions, error) {
+		return &contracts.AzionApplicationOptions{
+			Application: contracts.AzionJsonDataApplication{Id: 1234, Name: "my-app"},
+			Origin:      contracts.AzionJsonDataOrigin{Id: 1, Name: "Default Origin", Address: []string{address}},
+			Domain:      contracts.AzionJsonDataDomain{Id: 5, Name: "www"},
+		}, nil
+	}
+}
+
+func TestDiff(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	color.NoColor = true
+
+	t.Run("manifest with drift", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "edge_applications"), httpmock.JSONFromString(`{"count": 1, "total_pages": 1, "results": [{"id": 1234, "name": "my-app"}]}`))
+		registerLive(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--file", "./fixtures/stack.yaml"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The account differs from ./fixtures/stack.yaml in 3 resources")
+		require.Equal(t, utils.ExitDrift, utils.ExitCode(err))
+		mock.Verify(t)
+		require.Equal(t, `Edge application "my-app":
+  ~ edge application "my-app" (1234)
+      caching: true -> false
+  + origin "api"
+  - response rule "legacy" (200)
+
+1 only in the manifest, 1 changed, 1 only in the account
+`, stdout.String())
+	})
+
+	t.Run("azion.json without drift", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		diffCmd := NewDiffCmd(f)
+		diffCmd.GetAzionJsonContent = azionJson("www.example.com")
+		cmd := NewCobraCmd(diffCmd)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Equal(t, "No differences between azion.json and the account\n", stdout.String())
+	})
+
+	t.Run("azion.json with drift as json", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		diffCmd := NewDiffCmd(f)
+		diffCmd.GetAzionJsonContent = azionJson("origin.example.com")
+		cmd := NewCobraCmd(diffCmd)
+		cmd.SetArgs([]string{"--format", "json"})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, utils.CodeDrift, utils.AsError(err).Code)
+		require.JSONEq(t, `{
+			"edge_application": "my-app",
+			"drift": true,
+			"changes": [{
+				"action": "update",
+				"kind": "origin",
+				"name": "Default Origin",
+				"id": "0cee30cd-1743-4202-b0dd-da9b636a6035",
+				"fields": [{"field": "addresses", "old": ["www.example.com"], "new": ["origin.example.com"]}]
+			}]
+		}`, stdout.String())
+	})
+
+	t.Run("edge application deleted from the account", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "edge_applications/1234"), httpmock.StatusStringResponse(404, "Not Found"))
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		diffCmd := NewDiffCmd(f)
+		diffCmd.GetAzionJsonContent = azionJson("www.example.com")
+		cmd := NewCobraCmd(diffCmd)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.Equal(t, utils.ExitDrift, utils.ExitCode(err))
+		require.Equal(t, `Edge application "my-app":
+  + edge application "my-app"
+  + origin "Default Origin"
+  + domain "www"
+
+3 only in the manifest, 0 changed, 0 only in the account
+`, stdout.String())
+	})
+
+	t.Run("azion.json as yaml with the fields", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		diffCmd := NewDiffCmd(f)
+		diffCmd.GetAzionJsonContent = azionJson("www.example.com")
+		cmd := NewCobraCmd(diffCmd)
+		cmd.SetArgs([]string{"--format", "yaml", "--fields", "edge_application,drift"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Equal(t, "edge_application: my-app\ndrift: false\n", stdout.String())
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerLive(mock)
+
+		f, _, _ := testutils.NewFactory(mock)
+		diffCmd := NewDiffCmd(f)
+		diffCmd.GetAzionJsonContent = azionJson("www.example.com")
+		cmd := NewCobraCmd(diffCmd)
+		cmd.SetArgs([]string{"--format", "text"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The output format 'text' is invalid. Use one of table, json, yaml, csv or tsv and try again")
+	})
+}
@@ -35,9 +35,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_functions create --name myjsfunc --code ./mycode/function.js --active false
-        $ azion edge_functions create --name withargs --code ./mycode/function.js --args ./args.json --active true
-        $ azion edge_functions create --in "create.json"
+        $ azion create edge-function --name myjsfunc --code ./mycode/function.js --active false
+        $ azion create edge-function --name withargs --code ./mycode/function.js --args ./args.json --active true
+        $ azion create edge-function --in "create.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			request := api.NewCreateRequest()
@@ -99,7 +99,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			response, err := client.Create(ctx, request)
 
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreateFunction.Error(), err)
+				return utils.WrapError(msg.ErrorCreateFunction, err)
 			}
 
 			fmt.Fprintf(f.IOStreams.Out, msg.EdgeFunctionCreateOutputSuccess, response.GetId())
@@ -8,6 +8,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/edge_functions"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_functions"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -20,7 +21,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_functions delete --function-id 1234
+        $ azion delete edge-function --function-id 1234
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("function-id") {
@@ -33,7 +34,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			err := client.Delete(ctx, function_id)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDeleteFunction.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDeleteFunction, err)
 			}
 
 			out := f.IOStreams.Out
@@ -5,13 +5,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_functions"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_functions"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -26,10 +26,11 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_functions describe --function-id 4312
-        $ azion edge_functions describe --function-id 1337 --with-code
-        $ azion edge_functions describe --function-id 1337 --out "./tmp/test.json" --format json
-        $ azion edge_functions describe --function-id 1337 --format json
+        $ azion describe edge-function --function-id 4312
+        $ azion describe edge-function --function-id 1337 --with-code
+        $ azion describe edge-function --function-id 1337 --out "./tmp/test.json" --format json
+        $ azion describe edge-function --function-id 1337 --format json
+        $ azion describe edge-function --function-id 1337 --format yaml
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("function-id") {
@@ -41,36 +42,24 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			ctx := context.Background()
 			function, err := client.Get(ctx, function_id)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorGetFunction.Error(), err)
+				return utils.WrapError(msg.ErrorGetFunction, err)
 			}
 
-			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, function)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
+				return err
 			}
-
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.EdgeFunctionFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
-				if err != nil {
-					return err
-				}
+			if p.DefaultTable() {
+				return p.Text(text(cmd, function))
 			}
 
-			return nil
+			return p.Describe(function, fields(function))
 		},
 	}
 
 	cmd.Flags().Int64VarP(&function_id, "function-id", "f", 0, msg.EdgeFunctionFlagId)
 	cmd.Flags().Bool("with-code", false, msg.EdgeFunctionDescribeFlagWithCode)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeFunctionDescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeFunctionDescribeFlagFormat)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.EdgeFunctionDescribeHelpFlag)
 
 	return cmd
@@ -82,36 +71,37 @@ func serializeToJson(data interface{}) string {
 	return string(serialized)
 }
 
-func format(cmd *cobra.Command, function api.EdgeFunctionResponse) ([]byte, error) {
-
+func text(cmd *cobra.Command, function api.EdgeFunctionResponse) []byte {
 	var b bytes.Buffer
 
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+	b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(function.GetId()))))
+	b.Write([]byte(fmt.Sprintf("Name: %s\n", function.GetName())))
+	b.Write([]byte(fmt.Sprintf("Active: %t\n", function.GetActive())))
+	b.Write([]byte(fmt.Sprintf("Language: %s\n", function.GetLanguage())))
+	b.Write([]byte(fmt.Sprintf("Reference Count: %d\n", uint64(function.GetReferenceCount()))))
+	b.Write([]byte(fmt.Sprintf("Modified at: %s\n", function.GetModified())))
+	b.Write([]byte(fmt.Sprintf("Initiator Type: %s\n", function.GetInitiatorType())))
+	b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", function.GetLastEditor())))
+	b.Write([]byte(fmt.Sprintf("Function to run: %s\n", function.GetFunctionToRun())))
+	b.Write([]byte(fmt.Sprintf("JSON Args: %s\n", serializeToJson(function.GetJsonArgs()))))
+	if cmd.Flags().Changed("with-code") {
+		b.Write([]byte(fmt.Sprintf("Code:\n%s\n", function.GetCode())))
 	}
 
-	if format == "json" || cmd.Flags().Changed("out") {
-		file, err := json.MarshalIndent(function, "", " ")
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
-	} else {
-		b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(function.GetId()))))
-		b.Write([]byte(fmt.Sprintf("Name: %s\n", function.GetName())))
-		b.Write([]byte(fmt.Sprintf("Active: %t\n", function.GetActive())))
-		b.Write([]byte(fmt.Sprintf("Language: %s\n", function.GetLanguage())))
-		b.Write([]byte(fmt.Sprintf("Reference Count: %d\n", uint64(function.GetReferenceCount()))))
-		b.Write([]byte(fmt.Sprintf("Modified at: %s\n", function.GetModified())))
-		b.Write([]byte(fmt.Sprintf("Initiator Type: %s\n", function.GetInitiatorType())))
-		b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", function.GetLastEditor())))
-		b.Write([]byte(fmt.Sprintf("Function to run: %s\n", function.GetFunctionToRun())))
-		b.Write([]byte(fmt.Sprintf("JSON Args: %s\n", serializeToJson(function.GetJsonArgs()))))
-		if cmd.Flags().Changed("with-code") {
-			b.Write([]byte(fmt.Sprintf("Code:\n%s\n", function.GetCode())))
-		}
+	return b.Bytes()
+}
 
-		return b.Bytes(), nil
+func fields(function api.EdgeFunctionResponse) []printer.Field {
+	return []printer.Field{
+		{Label: "ID", Value: function.GetId()},
+		{Label: "Name", Value: function.GetName()},
+		{Label: "Active", Value: function.GetActive()},
+		{Label: "Language", Value: function.GetLanguage()},
+		{Label: "Reference Count", Value: function.GetReferenceCount()},
+		{Label: "Modified at", Value: function.GetModified()},
+		{Label: "Initiator Type", Value: function.GetInitiatorType()},
+		{Label: "Last Editor", Value: function.GetLastEditor()},
+		{Label: "Function to run", Value: function.GetFunctionToRun()},
+		{Label: "JSON Args", Value: serializeToJson(function.GetJsonArgs())},
 	}
 }
@@ -2,8 +2,6 @@ package list
 
 import (
 	"context"
-	"fmt"
-	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_functions"
@@ -11,10 +9,8 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
-
-	table "github.com/MaxwelMazur/tablecli"
-	"github.com/fatih/color"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
@@ -27,77 +23,70 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_functions list --details
-		$ azion edge_functions list --order_by "id"
-		$ azion edge_functions list --page 1  
-		$ azion edge_functions list --page_size 5
-		$ azion edge_functions list --sort "asc" 
+		$ azion list edge-function --details
+		$ azion list edge-function --order_by "id"
+		$ azion list edge-function --page 1  
+		$ azion list edge-function --page_size 5
+		$ azion list edge-function --sort "asc" 
+		$ azion list edge-function --format json
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			headers := []string{"ID", "NAME", "LANGUAGE", "ACTIVE"}
+			if opts.Details {
+				headers = append(headers, "LAST EDITOR", "MODIFIED", "REFERENCE COUNT", "INITIATOR_TYPE")
+			}
+
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+			if err != nil {
+				return err
+			}
+			p.NoHeader = opts.Page > 1
+
 			var numberPage int64 = opts.Page
 			if !cmd.Flags().Changed("page") && !cmd.Flags().Changed("page_size") {
 				for {
-					pages, err := PrintTable(f, opts, &numberPage)
+					pages, err := PrintTable(f, opts, &numberPage, p)
 					if numberPage > pages {
-						return nil
+						return p.Close()
 					}
 					if err != nil {
-						return fmt.Errorf(msg.ErrorGetFunctions.Error(), err)
+						return utils.WrapError(msg.ErrorGetFunctions, err)
 					}
 				}
 			}
 
-			if _, err := PrintTable(f, opts, &numberPage); err != nil {
-				return fmt.Errorf(msg.ErrorGetFunctions.Error(), err)
+			if _, err := PrintTable(f, opts, &numberPage, p); err != nil {
+				return utils.WrapError(msg.ErrorGetFunctions, err)
 			}
-			return nil
+			return p.Close()
 		},
 	}
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.EdgeFunctionListHelpFlag)
 	return cmd
 }
 
-func PrintTable(f *cmdutil.Factory, opts *contracts.ListOptions, numberPage *int64) (int64, error) {
+func PrintTable(f *cmdutil.Factory, opts *contracts.ListOptions, numberPage *int64, p *printer.Printer) (int64, error) {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
 
-	table.DefaultWriter = f.IOStreams.Out
-	tbl := table.New("ID", "NAME", "LANGUAGE", "ACTIVE")
 	fields := []string{"GetId()", "GetName()", "GetLanguage()", "GetActive()"}
 
 	functions, pages, err := client.List(ctx, opts)
 	if err != nil {
-		return 0, fmt.Errorf(msg.ErrorGetFunctions.Error(), err)
+		return 0, utils.WrapError(msg.ErrorGetFunctions, err)
 	}
 
 	if opts.Details {
 		fields = append(fields, "GetLastEditor()", "GetModified()", "GetReferenceCount()", "GetInitiatorType()")
-		tbl = table.New("ID", "NAME", "LANGUAGE", "ACTIVE", "LAST EDITOR", "MODIFIED", "REFERENCE COUNT", "INITIATOR_TYPE")
 	}
 
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
-	rows := printer.BuildRows(functions, fields)
-	for _, row := range rows {
-		if len(row) == 8 {
-			tbl.AddRow(row[0], row[1], row[2], row[3], row[4], row[5], row[6], row[7])
-		} else {
-			tbl.AddRow(row[0], row[1], row[2], row[3])
-		}
-	}
-
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	if *numberPage == 1 {
-		tbl.PrintHeader(format)
-	}
+	p.AddRows(functions, fields)
 
-	for _, row := range tbl.GetRows() {
-		tbl.PrintRow(format, row)
+	if err := p.Flush(); err != nil {
+		return 0, err
 	}
 
 	*numberPage += 1
@@ -36,11 +36,11 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_functions update --function-id 1234 --name 'Hello'
-		$ azion edge_functions update -f 4185 --code ./mycode/function.js --args ./mycode/myargs.json
-		$ azion edge_functions update -f 9123 --active true
-		$ azion edge_functions update -f 9123 --active false
-		$ azion edge_functions update --in "update.json"
+		$ azion update edge-function --function-id 1234 --name 'Hello'
+		$ azion update edge-function -f 4185 --code ./mycode/function.js --args ./mycode/myargs.json
+		$ azion update edge-function -f 9123 --active true
+		$ azion update edge-function -f 9123 --active false
+		$ azion update edge-function --in "update.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// either function-id or in path should be passed
@@ -110,7 +110,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			response, err := client.Update(ctx, &request)
 
 			if err != nil {
-				return fmt.Errorf(msg.ErrorUpdateFunction.Error(), err)
+				return utils.WrapError(msg.ErrorUpdateFunction, err)
 			}
 
 			fmt.Fprintf(f.IOStreams.Out, "Updated Edge Function with ID %v\n", response.GetId())
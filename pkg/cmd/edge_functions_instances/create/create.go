@@ -31,9 +31,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
- 		$ azion edge_functions_instances create --application-id 1673635839 --function-id 12314 --name "ffcafe222sdsdffdf"
-		$ azion edge_functions_instances create -a 1673635839 -f 12314 --name "ffcafe222sdsdffdf"
-        $ azion edge_functions_instances create -a 1673635839 --in "create.json"
+ 		$ azion create edge-function-instance --application-id 1673635839 --function-id 12314 --name "ffcafe222sdsdffdf"
+		$ azion create edge-function-instance -a 1673635839 -f 12314 --name "ffcafe222sdsdffdf"
+        $ azion create edge-function-instance -a 1673635839 --in "create.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			request := api.CreateFuncInstancesRequest{}
@@ -66,7 +66,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.CreateFuncInstances(context.Background(), &request, fields.ApplicationID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreate.Error(), err)
+				return utils.WrapError(msg.ErrorCreate, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.EdgeFuncInstanceCreateOutputSuccess, response.GetId())
 			return nil
@@ -8,6 +8,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/edge_functions_instances"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -22,8 +23,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		  $ azion edge_functions_instances delete --application-id 1673635839 --instance-id 12312
-		  $ azion edge_functions_instances delete -a 1673635839 -i 12312
+		  $ azion delete edge-function-instance --application-id 1673635839 --instance-id 12312
+		  $ azion delete edge-function-instance -a 1673635839 -i 12312
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("instance-id") {
@@ -35,7 +36,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 
 			err := client.DeleteFunctionInstance(ctx, applicationID, functionInstID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDeleteFuncInst.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDeleteFuncInst, err)
 			}
 
 			out := f.IOStreams.Out
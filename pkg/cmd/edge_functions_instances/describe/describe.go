@@ -2,19 +2,14 @@ package describe
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"path/filepath"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/edge_functions_instances"
 
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
@@ -33,9 +28,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-      $ azion edge_functions_instances describe --application-id 1674767911 --instance-id 31223
-      $ azion edge_functions_instances describe --application-id 1674767911 --instance-id 31223 --format json
-      $ azion edge_functions_instances describe --application-id 1674767911 --instance-id 31223 --out "./tmp/test.json"
+      $ azion describe edge-function-instance --application-id 1674767911 --instance-id 31223
+      $ azion describe edge-function-instance --application-id 1674767911 --instance-id 31223 --format json
+      $ azion describe edge-function-instance --application-id 1674767911 --instance-id 31223 --out "./tmp/test.json"
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("instance-id") {
@@ -46,56 +41,31 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			ctx := context.Background()
 			instance, err := client.GetFuncInstance(ctx, applicationID, instanceID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorGetEdgeFuncInstances.Error(), err)
+				return utils.WrapError(msg.ErrorGetEdgeFuncInstances, err)
 			}
 
-			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, instance)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.EdgeFuncInstanceFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
-				if err != nil {
-					return err
-				}
+				return err
 			}
 
-			return nil
+			return p.Describe(instance, fields(instance))
 		},
 	}
 
 	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.ApplicationFlagId)
 	cmd.Flags().Int64VarP(&instanceID, "instance-id", "i", 0, msg.EdgeFuncInstanceFlagId)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeFuncInstanceDescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeFuncInstanceDescribeFlagFormat)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.EdgeFuncInstanceDescribeHelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, instance api.FunctionsInstancesResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+func fields(instance api.FunctionsInstancesResponse) []printer.Field {
+	return []printer.Field{
+		{Label: "Edge Function Instance ID", Value: instance.GetId()},
+		{Label: "Instance Name", Value: instance.GetName()},
+		{Label: "Edge Function ID", Value: instance.GetEdgeFunctionId()},
+		{Label: "Args", Value: instance.GetArgs()},
 	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(instance, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Edge Function Instance ID: ", instance.GetId())
-	tbl.AddRow("Instance Name: ", instance.GetName())
-	tbl.AddRow("Edge Function ID: ", instance.GetEdgeFunctionId())
-	tbl.AddRow("Args: ", instance.GetArgs())
-	return tbl.GetByteFormat(), nil
 }
@@ -34,9 +34,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_functions_instances update -a 1674767911 -i 43121 -f 1209
-        $ azion edge_functions_instances update --application-id 1674767911 --instance-id 2121 --function-id 1212 --name updated
-        $ azion edge_functions_instances update  -a 1674767911 -i 43121 --in "update.json"
+        $ azion update edge-function-instance -a 1674767911 -i 43121 -f 1209
+        $ azion update edge-function-instance --application-id 1674767911 --instance-id 2121 --function-id 1212 --name updated
+        $ azion update edge-function-instance  -a 1674767911 -i 43121 --in "update.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if cmd.Flags().Changed("in") && (!cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("instance-id")) {
@@ -78,7 +78,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.UpdateInstance(context.Background(), &request, fields.ApplicationID, fields.InstanceID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorUpdateFuncInstance.Error(), err)
+				return utils.WrapError(msg.ErrorUpdateFuncInstance, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.EdgeFuncInstanceUpdateOutputSuccess, response.GetId())
 			return nil
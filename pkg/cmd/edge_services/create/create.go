@@ -30,8 +30,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services create --name "Hello"
-		$ azion edge_services create --in "<path>/create.json"
+		$ azion create edge-service --name "Hello"
+		$ azion create edge-service --in "<path>/create.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -24,7 +24,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services delete --service-id 1234
+        $ azion delete edge-service --service-id 1234
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") {
@@ -44,7 +44,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	deleteCmd.Flags().Int64VarP(&service_id, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	deleteCmd.Flags().Int64Var(&service_id, "service-id", 0, msg.EdgeServiceFlagId)
 	deleteCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceDeleteFlagHelp)
 
 	return deleteCmd
@@ -3,15 +3,14 @@ package describe
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
-	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
 	"github.com/spf13/cobra"
@@ -28,9 +27,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services describe --service-id 4312
-		$ azion edge_services describe --service-id 1337 --with-variables
-		$ azion edge_services describe --service-id 1337 --format json
+		$ azion describe edge-service --service-id 4312
+		$ azion describe edge-service --service-id 1337 --with-variables
+		$ azion describe edge-service --service-id 1337 --format json
+		$ azion describe edge-service --service-id 1337 --format yaml --out "./tmp/service.yaml"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") {
@@ -52,33 +52,20 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			out := f.IOStreams.Out
-			formattedService, err := format(cmd, service)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
+				return err
 			}
-
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedService, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.EdgeServiceFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedService[:])
-				if err != nil {
-					return err
-				}
+			if p.DefaultTable() {
+				return p.Text(text(cmd, service))
 			}
 
-			return nil
-
+			return p.Describe(service, fields(service))
 		},
 	}
-	describeCmd.Flags().Int64VarP(&service_id, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	describeCmd.Flags().Int64Var(&service_id, "service-id", 0, msg.EdgeServiceFlagId)
 	describeCmd.Flags().Bool("with-variables", false, msg.EdgeServiceDescribeFlagWithVariable)
-	describeCmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeServiceFlagOut)
-	describeCmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeServiceFlagFormat)
+	cmdutil.AddOutputFlags(describeCmd, &opts.OutputOptions)
 	describeCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceDescribeHelpFlag)
 
 	return describeCmd
@@ -98,35 +85,33 @@ func describeService(client *sdk.APIClient, service_id int64, withVariables bool
 	return &resp, nil
 }
 
-func format(cmd *cobra.Command, service *sdk.ServiceResponse) ([]byte, error) {
-
+func text(cmd *cobra.Command, service *sdk.ServiceResponse) []byte {
 	var b bytes.Buffer
 
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+	b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(service.GetId()))))
+	b.Write([]byte(fmt.Sprintf("Name: %s\n", service.GetName())))
+	b.Write([]byte(fmt.Sprintf("Active: %t\n", service.GetActive())))
+	b.Write([]byte(fmt.Sprintf("Updated at: %s\n", service.GetUpdatedAt())))
+	b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", service.GetLastEditor())))
+	b.Write([]byte(fmt.Sprintf("Bound Nodes: %d\n", uint64(service.GetBoundNodes()))))
+	b.Write([]byte(fmt.Sprintf("Permissions: %s\n", service.GetPermissions())))
+	if cmd.Flags().Changed("with-variables") {
+		b.Write([]byte("Variables:\n"))
+		for _, variable := range *service.Variables {
+			b.Write([]byte(fmt.Sprintf(" Name: %s\tValue: %s\n", variable.Name, variable.Value)))
+		}
 	}
+	return b.Bytes()
+}
 
-	if format == "json" || cmd.Flags().Changed("out") {
-		file, err := json.MarshalIndent(service, "", " ")
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
-	} else {
-		b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(service.GetId()))))
-		b.Write([]byte(fmt.Sprintf("Name: %s\n", service.GetName())))
-		b.Write([]byte(fmt.Sprintf("Active: %t\n", service.GetActive())))
-		b.Write([]byte(fmt.Sprintf("Updated at: %s\n", service.GetUpdatedAt())))
-		b.Write([]byte(fmt.Sprintf("Last Editor: %s\n", service.GetLastEditor())))
-		b.Write([]byte(fmt.Sprintf("Bound Nodes: %d\n", uint64(service.GetBoundNodes()))))
-		b.Write([]byte(fmt.Sprintf("Permissions: %s\n", service.GetPermissions())))
-		if cmd.Flags().Changed("with-variables") {
-			b.Write([]byte("Variables:\n"))
-			for _, variable := range *service.Variables {
-				b.Write([]byte(fmt.Sprintf(" Name: %s\tValue: %s\n", variable.Name, variable.Value)))
-			}
-		}
-		return b.Bytes(), nil
+func fields(service *sdk.ServiceResponse) []printer.Field {
+	return []printer.Field{
+		{Label: "ID", Value: service.GetId()},
+		{Label: "Name", Value: service.GetName()},
+		{Label: "Active", Value: service.GetActive()},
+		{Label: "Updated at", Value: service.GetUpdatedAt()},
+		{Label: "Last Editor", Value: service.GetLastEditor()},
+		{Label: "Bound Nodes", Value: service.GetBoundNodes()},
+		{Label: "Permissions", Value: service.GetPermissions()},
 	}
 }
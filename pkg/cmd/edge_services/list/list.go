@@ -1,9 +1,9 @@
 package list
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"io"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
@@ -27,11 +27,12 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services list [--details]
-		$ azion edge_services list --order_by "id"
-		$ azion edge_services list --page 1  
-		$ azion edge_services list --page_size 5
-		$ azion edge_services list --sort "asc" 
+		$ azion list edge-service [--details]
+		$ azion list edge-service --order_by "id"
+		$ azion list edge-service --page 1  
+		$ azion list edge-service --page_size 5
+		$ azion list edge-service --sort "asc" 
+		$ azion list edge-service --format json --out services.json
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, err := requests.CreateClient(f)
@@ -39,7 +40,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			if err := listAllServices(client, f.IOStreams.Out, opts); err != nil {
+			if err := listAllServices(client, f, opts); err != nil {
 				return err
 			}
 			return nil
@@ -47,12 +48,13 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmdutil.AddAzionApiFlags(listCmd, opts)
+	cmdutil.AddOutputFlags(listCmd, &opts.OutputOptions)
 	listCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceListFlagHelp)
 
 	return listCmd
 }
 
-func listAllServices(client *sdk.APIClient, out io.Writer, opts *contracts.ListOptions) error {
+func listAllServices(client *sdk.APIClient, f *cmdutil.Factory, opts *contracts.ListOptions) error {
 	c := context.Background()
 	api := client.DefaultApi
 
@@ -75,13 +77,25 @@ func listAllServices(client *sdk.APIClient, out io.Writer, opts *contracts.ListO
 
 	services := resp.Services
 
-	tp := printer.NewTab(out)
 	if opts.Details {
 		fields = append(fields, "LastEditor", "UpdatedAt", "Active", "BoundNodes")
 		headers = append(headers, "LAST EDITOR", "LAST MODIFIED", "ACTIVE", "BOUND NODES")
 	}
 
-	tp.PrintWithHeaders(services, fields, headers)
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	if p.DefaultTable() {
+		var b bytes.Buffer
+		printer.NewTab(&b).PrintWithHeaders(services, fields, headers)
+		return p.Text(b.Bytes())
+	}
+
+	p.AddRows(services, fields)
+	if err := p.Flush(); err != nil {
+		return err
+	}
+	return p.Close()
 }
@@ -38,8 +38,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services resources create --service-id 1234 --name "/tmp/test.txt" --content-type text --content-file "./text.txt"
-		$ azion edge_services resources create --service-id 1234 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
+		$ azion create edge-service-resource --service-id 1234 --name "/tmp/test.txt" --content-type text --content-file "./text.txt"
+		$ azion create edge-service-resource --service-id 1234 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") {
@@ -128,7 +128,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	createCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	createCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	createCmd.Flags().StringVar(&fields.Name, "name", "", msg.EdgeServiceResourceCreateFlagName)
 	createCmd.Flags().StringVar(&fields.Trigger, "trigger", "", msg.EdgeServiceResourceCreateFlagTrigger)
 	createCmd.Flags().StringVar(&fields.ContentType, "content-type", "", msg.EdgeServiceResourceCreateFlagContentType)
@@ -3,16 +3,15 @@ package describe
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
-	"path/filepath"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
 	"github.com/aziontech/azion-cli/pkg/cmd/edge_services/requests"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeservices"
 	"github.com/spf13/cobra"
@@ -34,7 +33,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services resources describe --service-id 1234 --resource-id 80312
+        $ azion describe edge-service-resource --service-id 1234 --resource-id 80312
+        $ azion describe edge-service-resource --service-id 1234 --resource-id 80312 --format json
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") || !cmd.Flags().Changed("resource-id") {
@@ -51,34 +51,21 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			out := f.IOStreams.Out
-			formattedResource, err := format(cmd, resource)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
+				return err
 			}
-
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedResource, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.EdgeServiceFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedResource[:])
-				if err != nil {
-					return err
-				}
+			if p.DefaultTable() {
+				return p.Text(text(resource))
 			}
 
-			return nil
-
+			return p.Describe(resource, describeFields(resource))
 		},
 	}
 
-	describeCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	describeCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	describeCmd.Flags().Int64VarP(&fields.ResourceId, "resource-id", "r", 0, msg.EdgeServiceResourceFlagId)
-	describeCmd.Flags().StringVar(&opts.OutPath, "out", "", msg.EdgeServiceFlagOut)
-	describeCmd.Flags().StringVar(&opts.Format, "format", "", msg.EdgeServiceFlagFormat)
+	cmdutil.AddOutputFlags(describeCmd, &opts.OutputOptions)
 	describeCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceResourceDescribeFlagHelp)
 
 	return describeCmd
@@ -99,29 +86,24 @@ func describeResource(client *sdk.APIClient, out io.Writer, service_id int64, re
 	return &resp, nil
 }
 
-func format(cmd *cobra.Command, resource *sdk.ResourceDetail) ([]byte, error) {
-
+func text(resource *sdk.ResourceDetail) []byte {
 	var b bytes.Buffer
 
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
-	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		file, err := json.MarshalIndent(resource, "", " ")
-		if err != nil {
-			return nil, err
-		}
-		return file, nil
-	} else {
-		b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(resource.GetId()))))
-		b.Write([]byte(fmt.Sprintf("Name: %s\n", resource.GetName())))
-		b.Write([]byte(fmt.Sprintf("Trigger: %s\n", resource.GetTrigger())))
-		b.Write([]byte(fmt.Sprintf("Content type: %s\n", resource.GetContentType())))
-		b.Write([]byte("Content: \n"))
-		b.Write([]byte(resource.GetContent()))
-		return b.Bytes(), nil
+	b.Write([]byte(fmt.Sprintf("ID: %d\n", uint64(resource.GetId()))))
+	b.Write([]byte(fmt.Sprintf("Name: %s\n", resource.GetName())))
+	b.Write([]byte(fmt.Sprintf("Trigger: %s\n", resource.GetTrigger())))
+	b.Write([]byte(fmt.Sprintf("Content type: %s\n", resource.GetContentType())))
+	b.Write([]byte("Content: \n"))
+	b.Write([]byte(resource.GetContent()))
+	return b.Bytes()
+}
 
+func describeFields(resource *sdk.ResourceDetail) []printer.Field {
+	return []printer.Field{
+		{Label: "ID", Value: resource.GetId()},
+		{Label: "Name", Value: resource.GetName()},
+		{Label: "Trigger", Value: resource.GetTrigger()},
+		{Label: "Content type", Value: resource.GetContentType()},
+		{Label: "Content", Value: resource.GetContent()},
 	}
 }
@@ -1,9 +1,9 @@
 package list
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"io"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/edge_services"
@@ -28,7 +28,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services resources list --service-id 1234 [--details]
+        $ azion list edge-service-resource --service-id 1234 [--details]
+        $ azion list edge-service-resource --service-id 1234 --format csv
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("service-id") {
@@ -40,7 +41,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				return err
 			}
 
-			if err := listAllResources(client, f.IOStreams.Out, opts, service_id); err != nil {
+			if err := listAllResources(client, f, opts, service_id); err != nil {
 				return err
 			}
 			return nil
@@ -48,13 +49,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmdutil.AddAzionApiFlags(listCmd, opts)
-	listCmd.Flags().Int64VarP(&service_id, "service-id", "s", 0, "Unique identifier of the Edge Service")
+	cmdutil.AddOutputFlags(listCmd, &opts.OutputOptions)
+	listCmd.Flags().Int64Var(&service_id, "service-id", 0, "Unique identifier of the Edge Service")
 	listCmd.Flags().BoolP("help", "h", false, msg.EdgeServiceResourceListFlagHelp)
 
 	return listCmd
 }
 
-func listAllResources(client *sdk.APIClient, out io.Writer, opts *contracts.ListOptions, service_id int64) error {
+func listAllResources(client *sdk.APIClient, f *cmdutil.Factory, opts *contracts.ListOptions, service_id int64) error {
 	c := context.Background()
 	api := client.DefaultApi
 
@@ -77,13 +79,25 @@ func listAllResources(client *sdk.APIClient, out io.Writer, opts *contracts.List
 
 	resources := resp.Resources
 
-	tp := printer.NewTab(out)
 	if opts.Details {
 		fields = append(fields, "LastEditor", "UpdatedAt", "ContentType", "Trigger")
 		headers = append(headers, "LAST EDITOR", "LAST MODIFIED", "CONTENT TYPE", "TRIGGER")
 	}
 
-	tp.PrintWithHeaders(resources, fields, headers)
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+	if err != nil {
+		return err
+	}
 
-	return nil
+	if p.DefaultTable() {
+		var b bytes.Buffer
+		printer.NewTab(&b).PrintWithHeaders(resources, fields, headers)
+		return p.Text(b.Bytes())
+	}
+
+	p.AddRows(resources, fields)
+	if err := p.Flush(); err != nil {
+		return err
+	}
+	return p.Close()
 }
@@ -43,8 +43,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion edge_services resources update --service-id 1234 --resource-id 69420 --name '/tmp/hello.txt'
-		$ azion edge_services resources update --service-id 1234 --resource-id 69420 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
+		$ azion update edge-service-resource --service-id 1234 --resource-id 69420 --name '/tmp/hello.txt'
+		$ azion update edge-service-resource --service-id 1234 --resource-id 69420 --name "/tmp/my_script.sh" --content-type shellscript --content-file "./text.txt"
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -144,7 +144,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		},
 	}
 
-	updateCmd.Flags().Int64VarP(&fields.ServiceId, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	updateCmd.Flags().Int64Var(&fields.ServiceId, "service-id", 0, msg.EdgeServiceFlagId)
 	updateCmd.Flags().Int64VarP(&fields.ResourceId, "resource-id", "r", 0, msg.EdgeServiceResourceFlagId)
 	updateCmd.Flags().StringVar(&fields.Name, "name", "", msg.EdgeServiceResourceUpdateFlagName)
 	updateCmd.Flags().StringVar(&fields.Trigger, "trigger", "", msg.EdgeServiceResourceUpdateFlagTrigger)
@@ -42,7 +42,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion edge_services update --service-id 1234 --name 'Hello'
+        $ azion update edge-service --service-id 1234 --name 'Hello'
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// either id parameter or in path should be passed
@@ -144,7 +144,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			return nil
 		},
 	}
-	updateCmd.Flags().Int64VarP(&fields.Id, "service-id", "s", 0, msg.EdgeServiceFlagId)
+	updateCmd.Flags().Int64Var(&fields.Id, "service-id", 0, msg.EdgeServiceFlagId)
 	updateCmd.Flags().StringVar(&fields.Name, "name", "", msg.EdgeServiceUpdateFlagName)
 	updateCmd.Flags().StringVar(&fields.Active, "active", "", msg.EdgeServiceUpdateFlagActive)
 	updateCmd.Flags().StringVar(&fields.Variables, "variables-file", "", msg.EdgeServiceUpdateFlagVariables)
@@ -0,0 +1,72 @@
+package edge_applications
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/export/edge_applications"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/manifest"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var applicationID int64
+	var file string
+
+	cmd := &cobra.Command{
+		Use:           msg.Usage,
+		Short:         msg.ShortDescription,
+		Long:          msg.LongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+        $ azion export edge-application --application-id 1673635839
+        $ azion export edge-application --application-id 1673635839 --file stack.yaml
+        $ azion export edge-application -a 1673635839 -f stack.json
+        `),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if !cmd.Flags().Changed("application-id") {
+				return msg.ErrorMissingApplicationID
+			}
+			return export(f, applicationID, file)
+		},
+	}
+
+	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.FlagApplicationID)
+	cmd.Flags().StringVarP(&file, "file", "f", "", msg.FlagFile)
+	cmd.Flags().BoolP("help", "h", false, msg.HelpFlag)
+	return cmd
+}
+
+func export(f *cmdutil.Factory, applicationID int64, file string) error {
+	logger.Debug("Running export edge-application command")
+
+	clients := manifest.NewClients(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	m, err := clients.Fetch(context.Background(), applicationID)
+	if err != nil {
+		if utils.AsError(err).Code == utils.CodeNotFound {
+			return utils.NewError(utils.CodeNotFound, fmt.Errorf(msg.ErrorApplicationNotFound.Error(), applicationID))
+		}
+		return err
+	}
+	m.Strip()
+
+	if file == "" {
+		data, err := m.Marshal(false)
+		if err != nil {
+			return err
+		}
+		_, err = f.IOStreams.Out.Write(data)
+		return err
+	}
+
+	if err := m.Write(file); err != nil {
+		return err
+	}
+	logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.Exported, m.EdgeApplication.Name, file))
+	return nil
+}
@@ -0,0 +1,154 @@
+package edge_applications
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/manifest"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func registerApplication(mock *httpmock.Registry) {
+	testutils.RegisterEdgeApplication(mock, map[string]httpmock.Responder{
+		"edge_applications/1234/functions_instances":         httpmock.JSONFromFile("./fixtures/instances.json"),
+		"edge_applications/1234/rules_engine/response/rules": httpmock.JSONFromFile("./fixtures/response_rules.json"),
+	})
+	mock.Register(httpmock.REST("GET", "edge_functions"), httpmock.JSONFromFile("./fixtures/functions.json"))
+	mock.Register(httpmock.REST("GET", "domains"), httpmock.JSONFromFile("./fixtures/domains.json"))
+}
+
+const exported = `edge_application:
+  name: my-app
+  delivery_protocol: http
+  active: true
+  application_acceleration: false
+  caching: true
+  device_detection: false
+  edge_firewall: false
+  edge_functions: false
+  image_optimization: false
+  l2_caching: false
+  load_balancer: false
+  raw_logs: false
+  web_application_firewall: false
+  origins:
+  - name: Default Origin
+    origin_type: single_origin
+    addresses:
+    - www.example.com
+    origin_protocol_policy: preserve
+    host_header: ${host}
+    hmac_authentication: false
+  cache_settings:
+  - name: Default Cache Settings
+    browser_cache_settings: honor
+    browser_cache_settings_maximum_ttl: 0
+    cdn_cache_settings: honor
+    cdn_cache_settings_maximum_ttl: 60
+    cache_by_query_string: ignore
+    enable_query_string_sort: false
+    cache_by_cookies: ignore
+    adaptive_delivery_action: ignore
+    enable_caching_for_post: false
+    enable_caching_for_options: false
+    l2_caching_enabled: false
+  function_instances:
+  - name: auth
+    edge_function: auth-function
+    args:
+      realm: admin
+  rules_engine:
+    request:
+    - name: Default Rule
+      criteria:
+      - - conditional: if
+          variable: ${uri}
+          operator: starts_with
+          input_value: /
+      behaviors:
+      - name: set_origin
+        target: Default Origin
+    response:
+    - name: auth
+      criteria:
+      - - conditional: if
+          variable: ${uri}
+          operator: starts_with
+          input_value: /
+      behaviors:
+      - name: run_function
+        target: auth
+  domains:
+  - name: www
+    cnames:
+    - www.example.com
+    cname_access_only: true
+    is_active: true
+`
+
+func TestExport(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("export to the standard output", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerApplication(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--application-id", "1234"})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		mock.Verify(t)
+		require.Equal(t, exported, stdout.String())
+	})
+
+	t.Run("export to a file", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		registerApplication(mock)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		path := filepath.Join(t.TempDir(), "stack.json")
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"-a", "1234", "-f", path})
+
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Equal(t, `Exported the edge application "my-app" to `+path+"\n", stdout.String())
+
+		// the manifest is one that apply reads
+		m, err := manifest.Read(path)
+		require.NoError(t, err)
+		require.Equal(t, "my-app", m.EdgeApplication.Name)
+		require.Equal(t, int64(0), m.EdgeApplication.ID)
+		require.Empty(t, m.EdgeApplication.Origins[0].Key)
+		require.Equal(t, "www", m.EdgeApplication.Domains[0].Name)
+	})
+
+	t.Run("application not found", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(httpmock.REST("GET", "edge_applications/1234"), httpmock.StatusStringResponse(404, "Not Found"))
+
+		f, _, _ := testutils.NewFactory(mock)
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--application-id", "1234"})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "The Edge Application 1234 doesn't exist. Run 'azion list edge-application' to check the ID of your Edge Application and try again")
+		require.Equal(t, utils.CodeNotFound, utils.AsError(err).Code)
+	})
+
+	t.Run("missing application id", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+
+		_, err := cmd.ExecuteC()
+		require.EqualError(t, err, "A required flag is missing. You must supply application-id as an argument. Run 'azion export edge-application --help' command to display more information and try again")
+	})
+}
@@ -0,0 +1,29 @@
+package export
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/export"
+	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/export/edge_applications"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   msg.Usage,
+		Short: msg.ShortDescription,
+		Long:  msg.LongDescription,
+		Example: heredoc.Doc(`
+		$ azion export --help
+		$ azion export edge-application --application-id 1673635839 --file stack.yaml
+        `),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.AddCommand(edgeApplications.NewCmd(f))
+
+	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
+	return cmd
+}
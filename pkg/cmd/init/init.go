@@ -11,6 +11,8 @@ import (
 	"github.com/aziontech/azion-cli/pkg/cmd/deploy"
 	"github.com/aziontech/azion-cli/pkg/cmd/dev"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/iostreams"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/utils"
@@ -20,16 +22,22 @@ import (
 	"go.uber.org/zap"
 )
 
-const (
-	REPO string = "https://github.com/aziontech/azioncli-template.git"
-)
-
 type InitInfo struct {
 	Name           string
 	Template       string
 	Mode           string
 	PathWorkingDir string
 	GlobalFlagAll  bool
+	TemplateSource string
+	TemplateRepo   string
+	Ref            string
+	Subdir         string
+	Manifest       *contracts.TemplateManifest
+	PackageManager string
+	InstallDeps    *bool
+	StartDev       *bool
+	Deploy         *bool
+	Refresh        bool
 }
 
 type InitCmd struct {
@@ -55,30 +63,36 @@ type InitCmd struct {
 	DeployCmd             func(f *cmdutil.Factory) *deploy.DeployCmd
 	DevCmd                func(f *cmdutil.Factory) *dev.DevCmd
 	ChangeDir             func(dir string) error
+	CopyDir               func(src, dst string, skip ...string) error
+	TemplateSources       func() ([]config.TemplateSource, error)
+	TemplateCacheDir      func() (string, error)
 }
 
 func NewInitCmd(f *cmdutil.Factory) *InitCmd {
 	return &InitCmd{
-		F:               f,
-		Io:              f.IOStreams,
-		GetWorkDir:      utils.GetWorkingDir,
-		FileReader:      os.ReadFile,
-		LookPath:        exec.LookPath,
-		IsDirEmpty:      utils.IsDirEmpty,
-		CleanDir:        utils.CleanDirectory,
-		WriteFile:       os.WriteFile,
-		OpenFile:        os.Open,
-		RemoveAll:       os.RemoveAll,
-		Rename:          os.Rename,
-		CreateTempDir:   os.MkdirTemp,
-		EnvLoader:       utils.LoadEnvVarsFromFile,
-		Stat:            os.Stat,
-		Mkdir:           os.MkdirAll,
-		GitPlainClone:   git.PlainClone,
-		ShouldDevDeploy: shouldDevDeploy,
-		DevCmd:          dev.NewDevCmd,
-		DeployCmd:       deploy.NewDeployCmd,
-		ChangeDir:       os.Chdir,
+		F:                f,
+		Io:               f.IOStreams,
+		GetWorkDir:       utils.GetWorkingDir,
+		FileReader:       os.ReadFile,
+		LookPath:         exec.LookPath,
+		IsDirEmpty:       utils.IsDirEmpty,
+		CleanDir:         utils.CleanDirectory,
+		WriteFile:        os.WriteFile,
+		OpenFile:         os.Open,
+		RemoveAll:        os.RemoveAll,
+		Rename:           os.Rename,
+		CreateTempDir:    os.MkdirTemp,
+		EnvLoader:        utils.LoadEnvVarsFromFile,
+		Stat:             os.Stat,
+		Mkdir:            os.MkdirAll,
+		GitPlainClone:    git.PlainClone,
+		ShouldDevDeploy:  shouldDevDeploy,
+		DevCmd:           dev.NewDevCmd,
+		DeployCmd:        deploy.NewDeployCmd,
+		ChangeDir:        os.Chdir,
+		CopyDir:          utils.CopyDirectory,
+		TemplateSources:  config.TemplateSources,
+		TemplateCacheDir: config.TemplateCacheDir,
 		CommandRunner: func(cmd string, envvars []string) (string, int, error) {
 			return utils.RunCommandWithOutput(envvars, cmd)
 		},
@@ -100,14 +114,33 @@ func NewCobraCmd(init *InitCmd, f *cmdutil.Factory) *cobra.Command {
 		$ azion init
 		$ azion init --help
 		$ azion init --name testproject
+		$ azion init --template-repo https://github.com/myorg/templates.git --ref v1.0.0 --subdir react
+		$ azion init --template-repo ./my-local-template
+		$ azion init --template internal-starter
+		$ azion init --refresh-templates
+		$ azion init --name testproject --preset vue --mode deliver --package-manager npm --install-deps --start-dev=false --deploy=false
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			info.GlobalFlagAll = f.GlobalFlagAll
+			info.InstallDeps = cmdutil.BoolFlag(cmd, "install-deps")
+			info.StartDev = cmdutil.BoolFlag(cmd, "start-dev")
+			info.Deploy = cmdutil.BoolFlag(cmd, "deploy")
 			return init.Run(info)
 		},
 	}
 
 	cobraCmd.Flags().StringVar(&info.Name, "name", "", msg.EdgeApplicationsInitFlagName)
+	cobraCmd.Flags().StringVar(&info.TemplateRepo, "template-repo", "", msg.InitFlagTemplateRepo)
+	cobraCmd.Flags().StringVar(&info.Ref, "ref", "", msg.InitFlagRef)
+	cobraCmd.Flags().StringVar(&info.Subdir, "subdir", "", msg.InitFlagSubdir)
+	cobraCmd.Flags().StringVar(&info.TemplateSource, "template", "", msg.InitFlagTemplate)
+	cobraCmd.Flags().StringVar(&info.Template, "preset", "", msg.InitFlagPreset)
+	cobraCmd.Flags().StringVar(&info.Mode, "mode", "", msg.InitFlagMode)
+	cobraCmd.Flags().StringVar(&info.PackageManager, "package-manager", "", msg.InitFlagPackageManager)
+	cobraCmd.Flags().Bool("install-deps", false, msg.InitFlagInstallDeps)
+	cobraCmd.Flags().Bool("start-dev", false, msg.InitFlagStartDev)
+	cobraCmd.Flags().Bool("deploy", false, msg.InitFlagDeploy)
+	cobraCmd.Flags().BoolVar(&info.Refresh, "refresh-templates", false, msg.InitFlagRefreshTemplates)
 	return cobraCmd
 }
 
@@ -118,6 +151,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 func (cmd *InitCmd) Run(info *InitInfo) error {
 	logger.Debug("Running init command")
 
+	if info.Refresh {
+		return cmd.refreshTemplates()
+	}
+
 	path, err := cmd.GetWorkDir()
 	if err != nil {
 		logger.Debug("Error while getting working directory", zap.Error(err))
@@ -131,6 +168,10 @@ func (cmd *InitCmd) Run(info *InitInfo) error {
 	} else {
 		// if name was not sent we ask for input, otherwise info.Name already has the value
 		if info.Name == "" {
+			if !cmd.F.CanPrompt() {
+				return fmt.Errorf(utils.ErrorMissingFlag.Error(), "name")
+			}
+
 			projName, err := askForInput(msg.InitProjectQuestion, thoth.GenerateName())
 			if err != nil {
 				return err
@@ -142,7 +183,16 @@ func (cmd *InitCmd) Run(info *InitInfo) error {
 
 	info.PathWorkingDir = info.PathWorkingDir + "/" + info.Name
 
-	err = cmd.selectVulcanTemplates(info)
+	err = cmd.selectTemplateSource(info)
+	if err != nil {
+		return err
+	}
+
+	if info.TemplateRepo != "" {
+		err = cmd.fetchTemplate(info)
+	} else {
+		err = cmd.selectVulcanTemplates(info)
+	}
 	if err != nil {
 		return err
 	}
@@ -159,28 +209,19 @@ func (cmd *InitCmd) Run(info *InitInfo) error {
 		return msg.ErrorWorkingDir
 	}
 
-	shouldDev, err := cmd.ShouldDevDeploy(info, "Do you want to start a local development server?")
-	if err != err {
+	deps := cmd.depsInstaller(info)
+	shouldDev, err := utils.Confirm(cmd.F, info.StartDev, "start-dev", func() (bool, error) {
+		return cmd.ShouldDevDeploy(info, msg.InitAskStartDev)
+	})
+	if err != nil {
 		return err
 	}
 	if shouldDev {
-		shouldDeps, err := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to start local development server")
-		if err != err {
+		err = deps.Install(msg.InitAskInstallDepsDev)
+		if err != nil {
 			return err
 		}
 
-		if shouldDeps {
-			answer, err := utils.GetPackageManager()
-			if err != nil {
-				return err
-			}
-			err = depsInstall(cmd, answer)
-			if err != nil {
-				logger.Debug("Error while installing project dependencies", zap.Error(err))
-				return msg.ErrorDeps
-			}
-		}
-
 		logger.Debug("Running dev command from init command")
 		dev := cmd.DevCmd(cmd.F)
 		err = dev.Run(cmd.F)
@@ -192,28 +233,18 @@ func (cmd *InitCmd) Run(info *InitInfo) error {
 		logger.FInfo(cmd.Io.Out, msg.InitDevCommand)
 	}
 
-	shouldDeploy, err := cmd.ShouldDevDeploy(info, "Do you want to deploy your project?")
-	if err != err {
+	shouldDeploy, err := utils.Confirm(cmd.F, info.Deploy, "deploy", func() (bool, error) {
+		return cmd.ShouldDevDeploy(info, msg.InitAskDeploy)
+	})
+	if err != nil {
 		return err
 	}
 	if shouldDeploy {
-		shouldDeps, err := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to deploy your project")
-		if err != err {
+		err = deps.Install(msg.InitAskInstallDepsDeploy)
+		if err != nil {
 			return err
 		}
 
-		if shouldDeps {
-			answer, err := utils.GetPackageManager()
-			if err != nil {
-				return err
-			}
-			err = depsInstall(cmd, answer)
-			if err != nil {
-				logger.Debug("Failed to install project dependencies")
-				return err
-			}
-		}
-
 		logger.Debug("Running deploy command from init command")
 		deploy := cmd.DeployCmd(cmd.F)
 		err = deploy.Run(cmd.F)
@@ -15,27 +15,39 @@ func (cmd *InitCmd) createTemplateAzion(info *InitInfo) error {
 		return msg.ErrorFailedCreatingAzionDirectory
 	}
 
-	azionJson := &contracts.AzionApplicationOptions{
-		Name:        info.Name,
-		Env:         "production",
-		Template:    info.Template,
-		Mode:        info.Mode,
-		VersionID:   "",
-		ProjectRoot: info.PathWorkingDir,
+	azionJson := &contracts.AzionApplicationOptions{}
+	azionJson.RtPurge.PurgeOnPublish = true
+
+	// custom templates may declare default values for azion.json in their manifest, which
+	// readManifest starts from the defaults above
+	if info.Manifest != nil {
+		*azionJson = info.Manifest.Azion
 	}
 
-	azionJson.Function.Name = "__DEFAULT__"
-	azionJson.Function.File = "./out/worker.js"
-	azionJson.Function.Args = "./azion/args.json"
-	azionJson.Domain.Name = "__DEFAULT__"
-	azionJson.Application.Name = "__DEFAULT__"
-	azionJson.Origin.Name = "__DEFAULT__"
-	azionJson.RtPurge.PurgeOnPublish = true
+	azionJson.Name = info.Name
+	azionJson.Template = info.Template
+	azionJson.Mode = info.Mode
+	azionJson.VersionID = ""
+	azionJson.ProjectRoot = info.PathWorkingDir
+
+	setDefault(&azionJson.Env, "production")
+	setDefault(&azionJson.Function.Name, "__DEFAULT__")
+	setDefault(&azionJson.Function.File, "./out/worker.js")
+	setDefault(&azionJson.Function.Args, "./azion/args.json")
+	setDefault(&azionJson.Domain.Name, "__DEFAULT__")
+	setDefault(&azionJson.Application.Name, "__DEFAULT__")
+	setDefault(&azionJson.Origin.Name, "__DEFAULT__")
 
 	return cmd.createJsonFile(azionJson, info)
 
 }
 
+func setDefault(field *string, value string) {
+	if *field == "" {
+		*field = value
+	}
+}
+
 func (cmd *InitCmd) createJsonFile(options *contracts.AzionApplicationOptions, info *InitInfo) error {
 	data, err := json.MarshalIndent(options, "", "  ")
 	if err != nil {
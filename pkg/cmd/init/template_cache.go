@@ -0,0 +1,302 @@
+package init
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	msg "github.com/aziontech/azion-cli/messages/init"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	vul "github.com/aziontech/azion-cli/pkg/vulcan"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/go-git/go-git/v5"
+	"go.uber.org/zap"
+)
+
+// templateCacheIndexFilename keeps the metadata of the cached templates
+const templateCacheIndexFilename = "index.json"
+
+// presetCacheDirname keeps a project created by Vulcan for each preset
+const presetCacheDirname = "presets"
+
+// templateCacheIndex describes the contents of the template cache
+type templateCacheIndex struct {
+	RefreshedAt   time.Time           `json:"refreshed_at"`
+	VulcanVersion string              `json:"vulcan_version"`
+	Presets       map[string][]string `json:"presets"`
+	Templates     []cachedTemplate    `json:"templates"`
+	PresetFiles   []cachedTemplate    `json:"preset_files"`
+}
+
+// cachedTemplate is a copy of a template repository, stored in Dir inside the cache directory
+type cachedTemplate struct {
+	Name     string `json:"name"`
+	Repo     string `json:"repo"`
+	Ref      string `json:"ref,omitempty"`
+	Commit   string `json:"commit,omitempty"`
+	Checksum string `json:"checksum"`
+	Dir      string `json:"dir"`
+}
+
+// refreshTemplates downloads the configured template sources, the list of Vulcan presets and a
+// project created from each preset into the template cache, so init can run without network access
+func (cmd *InitCmd) refreshTemplates() error {
+	cacheDir, err := cmd.TemplateCacheDir()
+	if err != nil {
+		logger.Debug("Error while getting template cache directory", zap.Error(err))
+		return msg.ErrorTemplateCache
+	}
+
+	sources, err := cmd.TemplateSources()
+	if err != nil {
+		logger.Debug("Error while reading configured template sources", zap.Error(err))
+		return msg.ErrorReadingTemplateSources
+	}
+
+	// the new cache is built aside and only replaces the current one when it is complete
+	tmpDir := cacheDir + ".tmp"
+	_ = cmd.RemoveAll(tmpDir)
+	if err := cmd.Mkdir(tmpDir, 0755); err != nil {
+		logger.Debug("Error while creating template cache directory", zap.Error(err))
+		return msg.ErrorTemplateCache
+	}
+	defer func() {
+		_ = cmd.RemoveAll(tmpDir)
+	}()
+
+	index := templateCacheIndex{
+		VulcanVersion: vul.Version(),
+	}
+
+	for i, source := range sources {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.InitCachingTemplate, source.Name, source.Repo))
+
+		dir := fmt.Sprintf("%d", i)
+		info := &InitInfo{TemplateRepo: source.Repo, Ref: source.Ref}
+		repo, err := cmd.cloneTemplateRepo(filepath.Join(tmpDir, dir), info)
+		if err != nil {
+			return err
+		}
+		_ = cmd.RemoveAll(filepath.Join(tmpDir, dir, ".git"))
+
+		checksum, err := utils.DirChecksum(filepath.Join(tmpDir, dir))
+		if err != nil {
+			logger.Debug("Error while calculating template checksum", zap.Error(err))
+			return msg.ErrorTemplateCache
+		}
+
+		index.Templates = append(index.Templates, cachedTemplate{
+			Name:     source.Name,
+			Repo:     source.Repo,
+			Ref:      source.Ref,
+			Commit:   headCommit(repo),
+			Checksum: checksum,
+			Dir:      dir,
+		})
+	}
+
+	logger.FInfo(cmd.Io.Out, msg.InitCachingPresets)
+	output, _, err := cmd.CommandRunner(vul.Command("", "presets ls"), []string{"CLEAN_OUTPUT_MODE=true"})
+	if err != nil {
+		logger.Debug("Error while listing Vulcan presets", zap.Error(err))
+		return msg.ErrorTemplateCache
+	}
+	index.Presets = parsePresets(output)
+
+	presetsDir := filepath.Join(tmpDir, presetCacheDirname)
+	if err := cmd.Mkdir(presetsDir, 0755); err != nil {
+		logger.Debug("Error while creating preset cache directory", zap.Error(err))
+		return msg.ErrorTemplateCache
+	}
+	presets := make([]string, 0, len(index.Presets))
+	for preset := range index.Presets {
+		presets = append(presets, preset)
+	}
+	sort.Strings(presets)
+
+	for _, preset := range presets {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.InitCachingPreset, preset))
+
+		// Vulcan creates the project in a directory with its name inside the current one
+		command := fmt.Sprintf("cd %s && %s", shellQuote(presetsDir), vul.Command("", "init --name "+preset+" --template "+preset))
+		if err := cmd.CommandRunInteractive(cmd.F, command); err != nil {
+			logger.Debug("Error while creating project from Vulcan preset", zap.String("preset", preset), zap.Error(err))
+			return msg.ErrorTemplateCache
+		}
+
+		dir := filepath.Join(presetCacheDirname, preset)
+		checksum, err := utils.DirChecksum(filepath.Join(tmpDir, dir))
+		if err != nil {
+			logger.Debug("Error while calculating preset checksum", zap.Error(err))
+			return msg.ErrorTemplateCache
+		}
+
+		index.PresetFiles = append(index.PresetFiles, cachedTemplate{
+			Name:     preset,
+			Checksum: checksum,
+			Dir:      dir,
+		})
+	}
+	index.RefreshedAt = time.Now()
+
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return msg.ErrorTemplateCache
+	}
+	if err := cmd.WriteFile(filepath.Join(tmpDir, templateCacheIndexFilename), data, 0644); err != nil {
+		logger.Debug("Error while writing template cache index", zap.Error(err))
+		return msg.ErrorTemplateCache
+	}
+
+	_ = cmd.RemoveAll(cacheDir)
+	if err := cmd.Rename(tmpDir, cacheDir); err != nil {
+		logger.Debug("Error while replacing template cache", zap.Error(err))
+		return msg.ErrorTemplateCache
+	}
+
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.InitTemplatesRefreshed, len(index.Templates), len(index.Presets), cacheDir))
+	return nil
+}
+
+// readTemplateCache returns the template cache index and the cache directory, or nil when there is no cache
+func (cmd *InitCmd) readTemplateCache() (*templateCacheIndex, string) {
+	cacheDir, err := cmd.TemplateCacheDir()
+	if err != nil {
+		return nil, ""
+	}
+
+	data, err := cmd.FileReader(filepath.Join(cacheDir, templateCacheIndexFilename))
+	if err != nil {
+		logger.Debug("Template cache not available", zap.Error(err))
+		return nil, ""
+	}
+
+	index := &templateCacheIndex{}
+	if err := json.Unmarshal(data, index); err != nil {
+		logger.Debug("Error while parsing template cache index", zap.Error(err))
+		return nil, ""
+	}
+
+	return index, cacheDir
+}
+
+// cachedTemplateDir returns the cached copy of the template repository, after verifying its checksum.
+// The directory is empty when the template isn't cached
+func (cmd *InitCmd) cachedTemplateDir(info *InitInfo) (string, error) {
+	index, cacheDir := cmd.readTemplateCache()
+	if index == nil {
+		return "", nil
+	}
+
+	for _, tmpl := range index.Templates {
+		if tmpl.Repo == info.TemplateRepo && tmpl.Ref == info.Ref {
+			return cmd.verifiedCacheDir(index, cacheDir, tmpl)
+		}
+	}
+
+	return "", nil
+}
+
+// cachedPresetDir returns the cached project created from the Vulcan preset, after verifying its checksum.
+// The directory is empty when the preset isn't cached
+func (cmd *InitCmd) cachedPresetDir(preset string) (string, error) {
+	index, cacheDir := cmd.readTemplateCache()
+	if index == nil {
+		return "", nil
+	}
+
+	for _, tmpl := range index.PresetFiles {
+		if tmpl.Name == strings.ToLower(preset) {
+			return cmd.verifiedCacheDir(index, cacheDir, tmpl)
+		}
+	}
+
+	return "", nil
+}
+
+// cachedPresets returns the names of the Vulcan presets with a cached project
+func (cmd *InitCmd) cachedPresets() []string {
+	index, _ := cmd.readTemplateCache()
+	if index == nil {
+		return nil
+	}
+
+	var presets []string
+	for _, tmpl := range index.PresetFiles {
+		presets = append(presets, tmpl.Name)
+	}
+	return presets
+}
+
+func (cmd *InitCmd) verifiedCacheDir(index *templateCacheIndex, cacheDir string, tmpl cachedTemplate) (string, error) {
+	dir := filepath.Join(cacheDir, tmpl.Dir)
+	checksum, err := utils.DirChecksum(dir)
+	if err != nil || checksum != tmpl.Checksum {
+		logger.Debug("Cached template checksum mismatch", zap.String("expected", tmpl.Checksum), zap.String("found", checksum), zap.Error(err))
+		return "", fmt.Errorf(msg.ErrorTemplateCacheChecksum.Error(), tmpl.Name)
+	}
+
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.InitUsingCachedTemplate, tmpl.Name, cacheAge(index.RefreshedAt)))
+	return dir, nil
+}
+
+// cachedModes returns the modes of the preset listed in the template cache
+func (cmd *InitCmd) cachedModes(preset string) ([]string, bool) {
+	index, _ := cmd.readTemplateCache()
+	if index == nil || index.VulcanVersion != vul.Version() {
+		return nil, false
+	}
+
+	modes, ok := index.Presets[strings.ToLower(preset)]
+	if ok {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.InitUsingCachedPresets, cacheAge(index.RefreshedAt)))
+	}
+	return modes, ok
+}
+
+// parsePresets reads the output of 'presets ls', with one 'preset (mode)' per line
+func parsePresets(output string) map[string][]string {
+	presets := map[string][]string{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		preset := strings.ToLower(fields[0])
+		mode := strings.Trim(fields[1], "()")
+		presets[preset] = append(presets[preset], mode)
+	}
+	return presets
+}
+
+// shellQuote quotes path to be used as a single argument by the shell
+func shellQuote(path string) string {
+	return "'" + strings.ReplaceAll(path, "'", `'\''`) + "'"
+}
+
+func headCommit(repo *git.Repository) string {
+	if repo == nil || repo.Storer == nil {
+		return ""
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return ""
+	}
+	return head.Hash().String()
+}
+
+// cacheAge describes how long ago the cache was refreshed
+func cacheAge(refreshedAt time.Time) string {
+	age := time.Since(refreshedAt)
+	switch {
+	case age < time.Hour:
+		return fmt.Sprintf("%d minutes", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%d hours", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%d days", int(age.Hours()/24))
+	}
+}
@@ -0,0 +1,110 @@
+package init
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestTemplateCache(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	const repo = "https://example.com/starters.git"
+	cacheDir := filepath.Join(t.TempDir(), "templates")
+
+	newCmd := func(online bool) *InitCmd {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := NewInitCmd(f)
+		cmd.TemplateCacheDir = func() (string, error) {
+			return cacheDir, nil
+		}
+		cmd.TemplateSources = func() ([]config.TemplateSource, error) {
+			return []config.TemplateSource{{Name: "starter", Repo: repo}}, nil
+		}
+		cmd.GitPlainClone = func(path string, isBare bool, o *git.CloneOptions) (*git.Repository, error) {
+			if !online {
+				return nil, errors.New("network unreachable")
+			}
+			_ = os.MkdirAll(filepath.Join(path, ".git"), os.ModePerm)
+			return nil, os.WriteFile(filepath.Join(path, "index.js"), []byte("hello"), 0644)
+		}
+		cmd.CommandRunner = func(command string, envvars []string) (string, int, error) {
+			if !online {
+				return "", 1, errors.New("network unreachable")
+			}
+			return "vue (Deliver)\nnext (Compute)\nnext (Deliver)\n", 0, nil
+		}
+		cmd.CommandRunInteractive = func(f *cmdutil.Factory, comm string) error {
+			if !online {
+				return errors.New("network unreachable")
+			}
+			// Vulcan init creates the project in the directory the command changes to
+			match := regexp.MustCompile(`^cd '(.+)' && .* init --name (\S+) --template (\S+)$`).FindStringSubmatch(comm)
+			require.NotNil(t, match, comm)
+			project := filepath.Join(match[1], match[2])
+			_ = os.MkdirAll(project, os.ModePerm)
+			_ = os.WriteFile(filepath.Join(project, "package.json"), []byte(`{"name": "`+match[2]+`"}`), 0644)
+			return os.WriteFile(filepath.Join(project, ".vulcan"), []byte("preset="+match[3]+"\n"), 0644)
+		}
+		return cmd
+	}
+
+	t.Run("refresh templates", func(t *testing.T) {
+		require.NoError(t, newCmd(true).refreshTemplates())
+		require.FileExists(t, filepath.Join(cacheDir, templateCacheIndexFilename))
+		require.FileExists(t, filepath.Join(cacheDir, "0", "index.js"))
+		require.NoDirExists(t, filepath.Join(cacheDir, "0", ".git"))
+		require.FileExists(t, filepath.Join(cacheDir, presetCacheDirname, "vue", ".vulcan"))
+		require.FileExists(t, filepath.Join(cacheDir, presetCacheDirname, "next", ".vulcan"))
+	})
+
+	t.Run("offline init uses the cache", func(t *testing.T) {
+		cmd := newCmd(false)
+		project := filepath.Join(t.TempDir(), "project")
+		info := &InitInfo{PathWorkingDir: project, TemplateRepo: repo, GlobalFlagAll: true}
+
+		require.NoError(t, cmd.fetchTemplate(info))
+		require.FileExists(t, filepath.Join(project, "index.js"))
+
+		modes, ok := cmd.cachedModes("next")
+		require.True(t, ok)
+		require.Equal(t, []string{"Compute", "Deliver"}, modes)
+	})
+
+	t.Run("offline init of a preset uses the cache", func(t *testing.T) {
+		defer os.Unsetenv("preset")
+
+		cmd := newCmd(false)
+		project := filepath.Join(t.TempDir(), "project")
+		info := &InitInfo{Name: "project", PathWorkingDir: project, Template: "vue"}
+
+		require.NoError(t, cmd.selectVulcanTemplates(info))
+		require.FileExists(t, filepath.Join(project, "package.json"))
+		require.Equal(t, "vue", info.Template)
+		require.Equal(t, "deliver", info.Mode)
+	})
+
+	t.Run("offline init of a preset not cached", func(t *testing.T) {
+		cmd := newCmd(false)
+		info := &InitInfo{Name: "project", PathWorkingDir: filepath.Join(t.TempDir(), "project"), Template: "angular"}
+		require.EqualError(t, cmd.selectVulcanTemplates(info), "network unreachable")
+	})
+
+	t.Run("corrupted cache", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(filepath.Join(cacheDir, "0", "index.js"), []byte("changed"), 0644))
+
+		cmd := newCmd(false)
+		info := &InitInfo{PathWorkingDir: filepath.Join(t.TempDir(), "project"), TemplateRepo: repo}
+		require.ErrorContains(t, cmd.fetchTemplate(info), "corrupted")
+	})
+}
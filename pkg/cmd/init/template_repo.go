@@ -0,0 +1,267 @@
+package init
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	msg "github.com/aziontech/azion-cli/messages/init"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"go.uber.org/zap"
+)
+
+// manifestFilename is the optional file of a custom template declaring its presets, modes and azion.json defaults
+const manifestFilename = "azion.template.json"
+
+const defaultTemplateOption = "Azion templates"
+
+// selectTemplateSource fills the template repository information from the source chosen
+// with --template or, when sources are configured in the CLI config, from the user's choice
+func (cmd *InitCmd) selectTemplateSource(info *InitInfo) error {
+	if info.TemplateRepo != "" {
+		return nil
+	}
+
+	sources, err := cmd.TemplateSources()
+	if err != nil {
+		logger.Debug("Error while reading configured template sources", zap.Error(err))
+		return msg.ErrorReadingTemplateSources
+	}
+
+	if info.TemplateSource == "" {
+		// without a choice, the default Azion templates are used
+		if len(sources) == 0 || info.GlobalFlagAll || !cmd.F.CanPrompt() {
+			return nil
+		}
+
+		opts := []string{defaultTemplateOption}
+		for _, source := range sources {
+			opts = append(opts, source.Name)
+		}
+		prompt := &survey.Select{
+			Message: msg.InitChooseTemplateSource,
+			Options: opts,
+		}
+		if err := survey.AskOne(prompt, &info.TemplateSource); err != nil {
+			return err
+		}
+		if info.TemplateSource == defaultTemplateOption {
+			info.TemplateSource = ""
+			return nil
+		}
+	}
+
+	for _, source := range sources {
+		if strings.EqualFold(source.Name, info.TemplateSource) {
+			info.TemplateRepo = source.Repo
+			if info.Ref == "" {
+				info.Ref = source.Ref
+			}
+			if info.Subdir == "" {
+				info.Subdir = source.Subdir
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf(msg.ErrorTemplateSourceNotFound.Error(), info.TemplateSource)
+}
+
+// fetchTemplate copies the template from a git repository or a local directory into the project directory
+func (cmd *InitCmd) fetchTemplate(info *InitInfo) error {
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.InitFetchingTemplate, info.TemplateRepo))
+
+	if empty, _ := cmd.IsDirEmpty(info.PathWorkingDir); !empty {
+		return fmt.Errorf(msg.ErrorDirNotEmpty.Error(), info.PathWorkingDir)
+	}
+
+	src := info.TemplateRepo
+	stat, err := cmd.Stat(info.TemplateRepo)
+	isLocal := err == nil && stat.IsDir()
+
+	// local directories are copied as they are, unless a git reference was requested
+	if !isLocal || info.Ref != "" {
+		tmp, err := cmd.CreateTempDir("", "azion-template-")
+		if err != nil {
+			logger.Debug("Error while creating temporary directory", zap.Error(err))
+			return utils.ErrorFetchingTemplates
+		}
+		defer func() {
+			_ = cmd.RemoveAll(tmp)
+		}()
+
+		src = tmp
+		if err := cmd.cloneTemplate(tmp, info); err != nil {
+			// without network access, the copy kept by --refresh-templates is used
+			cached, cacheErr := cmd.cachedTemplateDir(info)
+			if cacheErr != nil {
+				return cacheErr
+			}
+			if cached == "" {
+				return err
+			}
+			src = cached
+		}
+	}
+
+	src = filepath.Join(src, info.Subdir)
+	if stat, err := cmd.Stat(src); err != nil || !stat.IsDir() {
+		return fmt.Errorf(msg.ErrorTemplateSubdir.Error(), info.Subdir)
+	}
+
+	if err := cmd.CopyDir(src, info.PathWorkingDir, ".git"); err != nil {
+		logger.Debug("Error while copying template files", zap.Error(err))
+		return utils.ErrorMovingFiles
+	}
+
+	manifest, err := cmd.readManifest(info.PathWorkingDir)
+	if err != nil {
+		return err
+	}
+	info.Manifest = manifest
+
+	return cmd.selectManifestPreset(info)
+}
+
+func (cmd *InitCmd) cloneTemplate(dir string, info *InitInfo) error {
+	_, err := cmd.cloneTemplateRepo(dir, info)
+	return err
+}
+
+func (cmd *InitCmd) cloneTemplateRepo(dir string, info *InitInfo) (*git.Repository, error) {
+	options := &git.CloneOptions{
+		URL:   info.TemplateRepo,
+		Depth: 1,
+	}
+
+	if info.Ref == "" {
+		repo, err := cmd.GitPlainClone(dir, false, options)
+		if err != nil {
+			logger.Debug("Error while cloning template repository", zap.Error(err))
+			return nil, fmt.Errorf(msg.ErrorCloneTemplate.Error(), info.TemplateRepo)
+		}
+		return repo, nil
+	}
+
+	// the reference may be either a branch or a tag
+	for _, ref := range []plumbing.ReferenceName{
+		plumbing.NewBranchReferenceName(info.Ref),
+		plumbing.NewTagReferenceName(info.Ref),
+	} {
+		options.ReferenceName = ref
+		options.SingleBranch = true
+		repo, err := cmd.GitPlainClone(dir, false, options)
+		if err == nil {
+			return repo, nil
+		}
+		logger.Debug("Error while cloning template repository", zap.String("ref", ref.String()), zap.Error(err))
+		_ = cmd.RemoveAll(dir)
+	}
+
+	return nil, fmt.Errorf(msg.ErrorTemplateRef.Error(), info.Ref, info.TemplateRepo)
+}
+
+func (cmd *InitCmd) readManifest(dir string) (*contracts.TemplateManifest, error) {
+	path := filepath.Join(dir, manifestFilename)
+	data, err := cmd.FileReader(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		logger.Debug("Error while reading template manifest", zap.Error(err))
+		return nil, msg.ErrorTemplateManifest
+	}
+
+	// purging on publish stays on unless the manifest turns it off
+	manifest := &contracts.TemplateManifest{}
+	manifest.Azion.RtPurge.PurgeOnPublish = true
+	if err := json.Unmarshal(data, manifest); err != nil {
+		logger.Debug("Error while parsing template manifest", zap.Error(err))
+		return nil, msg.ErrorTemplateManifest
+	}
+
+	// the manifest is only used by the CLI, so it isn't kept in the project
+	_ = cmd.RemoveAll(path)
+
+	return manifest, nil
+}
+
+// selectManifestPreset chooses the preset and mode among the ones declared in the manifest,
+// asking the user when there is more than one option
+func (cmd *InitCmd) selectManifestPreset(info *InitInfo) error {
+	if info.Manifest == nil || len(info.Manifest.Presets) == 0 {
+		if info.Template == "" {
+			info.Template = "static"
+		}
+		if info.Mode == "" {
+			info.Mode = "deliver"
+		}
+		return nil
+	}
+
+	presets := map[string][]string{}
+	var names []string
+	for _, preset := range info.Manifest.Presets {
+		presets[preset.Name] = preset.Modes
+		names = append(names, preset.Name)
+	}
+
+	preset, err := cmd.choose(info, info.Template, "preset", msg.InitChoosePreset, names)
+	if err != nil {
+		return err
+	}
+	info.Template = preset
+
+	modes := presets[preset]
+	if len(modes) == 0 {
+		info.Mode = "deliver"
+		return nil
+	}
+
+	mode, err := cmd.choose(info, info.Mode, "mode", msg.InitChooseMode, modes)
+	if err != nil {
+		return err
+	}
+	info.Mode = mode
+
+	return nil
+}
+
+// choose returns the value sent through the flag, as long as it is one of the options,
+// or the option picked by the user
+func (cmd *InitCmd) choose(info *InitInfo, value, flagName, message string, opts []string) (string, error) {
+	if value != "" {
+		for _, opt := range opts {
+			if opt == value {
+				return value, nil
+			}
+		}
+		return "", fmt.Errorf(msg.ErrorTemplateOption.Error(), value, flagName, strings.Join(opts, ", "))
+	}
+
+	if len(opts) == 1 || info.GlobalFlagAll {
+		return opts[0], nil
+	}
+
+	if !cmd.F.CanPrompt() {
+		return "", fmt.Errorf(utils.ErrorMissingFlag.Error(), flagName)
+	}
+
+	answer := ""
+	prompt := &survey.Select{
+		Message: message,
+		Options: opts,
+	}
+	if err := survey.AskOne(prompt, &answer); err != nil {
+		return "", err
+	}
+	return answer, nil
+}
@@ -0,0 +1,109 @@
+package init
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const manifest = `{
+  "presets": [{"name": "react", "modes": ["deliver", "compute"]}, {"name": "vue", "modes": ["deliver"]}],
+  "azion": {"function": {"file": "./dist/worker.js"}, "build": {"cmd": "npm run build"}}
+}`
+
+func TestFetchTemplate(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	repo := t.TempDir()
+	_ = os.MkdirAll(filepath.Join(repo, "starters", "react", ".git"), os.ModePerm)
+	_ = os.WriteFile(filepath.Join(repo, "starters", "react", "index.js"), []byte("hello"), 0644)
+	_ = os.WriteFile(filepath.Join(repo, "starters", "react", manifestFilename), []byte(manifest), 0644)
+
+	t.Run("local directory with manifest", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := NewInitCmd(f)
+
+		project := filepath.Join(t.TempDir(), "project")
+		info := &InitInfo{
+			Name:           "project",
+			PathWorkingDir: project,
+			TemplateRepo:   repo,
+			Subdir:         "starters/react",
+			GlobalFlagAll:  true,
+		}
+
+		require.NoError(t, cmd.fetchTemplate(info))
+		require.Equal(t, "react", info.Template)
+		require.Equal(t, "deliver", info.Mode)
+
+		require.FileExists(t, filepath.Join(project, "index.js"))
+		require.NoFileExists(t, filepath.Join(project, manifestFilename))
+		require.NoDirExists(t, filepath.Join(project, ".git"))
+
+		require.NoError(t, cmd.createTemplateAzion(info))
+
+		data, err := os.ReadFile(filepath.Join(project, "azion", "azion.json"))
+		require.NoError(t, err)
+
+		conf := contracts.AzionApplicationOptions{}
+		require.NoError(t, json.Unmarshal(data, &conf))
+		require.Equal(t, "./dist/worker.js", conf.Function.File)
+		require.Equal(t, "npm run build", conf.Build.Cmd)
+		require.Equal(t, "__DEFAULT__", conf.Application.Name)
+		require.Equal(t, "production", conf.Env)
+		// the manifest leaves rt-purge out, so the default is kept
+		require.True(t, conf.RtPurge.PurgeOnPublish)
+	})
+
+	t.Run("manifest that turns purge off", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := NewInitCmd(f)
+		cmd.FileReader = func(path string) ([]byte, error) {
+			return []byte(`{"azion": {"rt-purge": {"purge_on_publish": false}}}`), nil
+		}
+		cmd.RemoveAll = func(path string) error {
+			return nil
+		}
+
+		m, err := cmd.readManifest(t.TempDir())
+		require.NoError(t, err)
+		require.False(t, m.Azion.RtPurge.PurgeOnPublish)
+	})
+
+	t.Run("missing subdir", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := NewInitCmd(f)
+
+		info := &InitInfo{
+			PathWorkingDir: filepath.Join(t.TempDir(), "project"),
+			TemplateRepo:   repo,
+			Subdir:         "missing",
+		}
+
+		require.Error(t, cmd.fetchTemplate(info))
+	})
+
+	t.Run("configured template source", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := NewInitCmd(f)
+		cmd.TemplateSources = func() ([]config.TemplateSource, error) {
+			return []config.TemplateSource{{Name: "internal", Repo: repo, Subdir: "starters/react"}}, nil
+		}
+
+		info := &InitInfo{TemplateSource: "internal"}
+		require.NoError(t, cmd.selectTemplateSource(info))
+		require.Equal(t, repo, info.TemplateRepo)
+		require.Equal(t, "starters/react", info.Subdir)
+
+		info = &InitInfo{TemplateSource: "unknown"}
+		require.Error(t, cmd.selectTemplateSource(info))
+	})
+}
@@ -9,6 +9,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/init"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	vul "github.com/aziontech/azion-cli/pkg/vulcan"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 )
@@ -28,6 +29,23 @@ func shouldDevDeploy(info *InitInfo, msg string) (bool, error) {
 	return shouldConfigure, nil
 }
 
+// depsInstaller returns the installer of the project dependencies, which asks the questions with ShouldDevDeploy
+func (cmd *InitCmd) depsInstaller(info *InitInfo) *utils.DepsInstaller {
+	return &utils.DepsInstaller{
+		F:              cmd.F,
+		Dir:            info.PathWorkingDir,
+		PackageManager: info.PackageManager,
+		Flag:           info.InstallDeps,
+		LookPath:       cmd.LookPath,
+		RunInteractive: cmd.CommandRunInteractive,
+		Stat:           cmd.Stat,
+		FileReader:     cmd.FileReader,
+		Ask: func(question string) (bool, error) {
+			return cmd.ShouldDevDeploy(info, question)
+		},
+	}
+}
+
 func askForInput(msg string, defaultIn string) (string, error) {
 	var userInput string
 	prompt := &survey.Input{
@@ -44,13 +62,28 @@ func askForInput(msg string, defaultIn string) (string, error) {
 }
 
 func (cmd *InitCmd) selectVulcanTemplates(info *InitInfo) error {
+	if info.Template == "" && !cmd.F.CanPrompt() {
+		return fmt.Errorf(utils.ErrorMissingFlag.Error(), "preset")
+	}
+
 	logger.FInfo(cmd.Io.Out, msg.InitGettingVulcan)
 
-	command := vul.Command("", "init --name "+info.Name)
+	params := "init --name " + info.Name
+	if info.Template != "" {
+		params += " --template " + info.Template
+	}
 
-	err := cmd.CommandRunInteractive(cmd.F, command)
+	err := cmd.CommandRunInteractive(cmd.F, vul.Command("", params))
 	if err != nil {
-		return err
+		// without network access, the project created from the preset by --refresh-templates is used
+		logger.Debug("Error while running Vulcan, looking for a cached preset", zap.Error(err))
+		cached, cacheErr := cmd.copyCachedPreset(info)
+		if cacheErr != nil {
+			return cacheErr
+		}
+		if !cached {
+			return err
+		}
 	}
 
 	preset, err := getVulcanEnvInfo(info)
@@ -62,19 +95,33 @@ func (cmd *InitCmd) selectVulcanTemplates(info *InitInfo) error {
 		preset = "vue"
 	}
 
-	command = vul.Command("", "presets ls --preset "+preset)
+	command := vul.Command("", "presets ls --preset "+preset)
 	output, _, err := cmd.CommandRunner(command, []string{"CLEAN_OUTPUT_MODE=true"})
+	var newLineSplit []string
 	if err != nil {
-		return err
+		modes, ok := cmd.cachedModes(preset)
+		if !ok {
+			return err
+		}
+		newLineSplit = modes
+	} else {
+		newLineSplit = strings.Split(output, "\n")
+		if newLineSplit[len(newLineSplit)-1] == "" {
+			newLineSplit = newLineSplit[:len(newLineSplit)-1]
+		}
 	}
 
-	newLineSplit := strings.Split(output, "\n")
-	if newLineSplit[len(newLineSplit)-1] == "" {
-		newLineSplit = newLineSplit[:len(newLineSplit)-1]
+	if info.Mode != "" {
+		info.Template = preset
+		info.Mode = strings.ToLower(info.Mode)
+		return nil
 	}
 
 	answer := ""
 	if len(newLineSplit) > 1 {
+		if !cmd.F.CanPrompt() {
+			return fmt.Errorf(utils.ErrorMissingFlag.Error(), "mode")
+		}
 		prompt := &survey.Select{
 			Message: "Choose a mode:",
 			Options: newLineSplit,
@@ -102,16 +149,36 @@ func (cmd *InitCmd) selectVulcanTemplates(info *InitInfo) error {
 	return nil
 }
 
-func depsInstall(cmd *InitCmd, packageManager string) error {
-	logger.FInfo(cmd.Io.Out, msg.InitInstallDeps)
-	command := fmt.Sprintf("%s install", packageManager)
-	err := cmd.CommandRunInteractive(cmd.F, command)
-	if err != nil {
-		logger.Debug("Error while running command with simultaneous output", zap.Error(err))
-		return msg.ErrorDeps
+// copyCachedPreset creates the project from the cached copy of its preset. It reports false when
+// the preset isn't cached
+func (cmd *InitCmd) copyCachedPreset(info *InitInfo) (bool, error) {
+	presets := cmd.cachedPresets()
+	if len(presets) == 0 {
+		return false, nil
 	}
 
-	return nil
+	// Vulcan asks for the preset when it isn't sent, so the CLI asks for it from the cached ones
+	preset := info.Template
+	if preset == "" {
+		prompt := &survey.Select{
+			Message: "Choose a preset:",
+			Options: presets,
+		}
+		if err := survey.AskOne(prompt, &preset); err != nil {
+			return false, err
+		}
+	}
+
+	dir, err := cmd.cachedPresetDir(preset)
+	if err != nil || dir == "" {
+		return false, err
+	}
+
+	if err := cmd.CopyDir(dir, info.PathWorkingDir); err != nil {
+		logger.Debug("Error while copying cached preset files", zap.Error(err))
+		return false, utils.ErrorMovingFiles
+	}
+	return true, nil
 }
 
 func getVulcanEnvInfo(info *InitInfo) (string, error) {
@@ -0,0 +1,184 @@
+package link
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/AlecAivazis/survey/v2"
+	msg "github.com/aziontech/azion-cli/messages/link"
+	apidom "github.com/aziontech/azion-cli/pkg/api/domains"
+	apiapp "github.com/aziontech/azion-cli/pkg/api/edge_applications"
+	apifunc "github.com/aziontech/azion-cli/pkg/api/edge_functions"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"go.uber.org/zap"
+)
+
+// adoptPageSize is the page size used to go through the resources of the account
+const adoptPageSize = 100
+
+// adoptResources fills azion.json with the existing edge application sent with --application-id,
+// or picked from the account with --adopt, and with its domain, edge function and origin.
+// This way the next deploy updates these resources instead of creating new ones
+func (cmd *LinkCmd) adoptResources(info *LinkInfo, conf *contracts.AzionApplicationOptions) error {
+	ctx := context.Background()
+	f := cmd.F
+	clientApp := apiapp.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+	if info.ApplicationID == 0 {
+		if !f.CanPrompt() {
+			return fmt.Errorf(utils.ErrorMissingFlag.Error(), "application-id")
+		}
+		id, err := cmd.pickApplication(ctx, clientApp)
+		if err != nil {
+			return err
+		}
+		info.ApplicationID = id
+	}
+
+	application, err := clientApp.Get(ctx, strconv.FormatInt(info.ApplicationID, 10))
+	if err != nil {
+		logger.Debug("Error while getting the edge application", zap.Error(err))
+		return utils.WrapError(msg.ErrorAdoptApplication, err)
+	}
+	conf.Application.Id = application.GetId()
+	conf.Application.Name = application.GetName()
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.LinkAdoptedResource, "edge application", application.GetName(), application.GetId()))
+
+	if err := cmd.adoptFunction(ctx, clientApp, info, conf); err != nil {
+		return err
+	}
+
+	if err := cmd.adoptDomain(ctx, info, conf); err != nil {
+		return err
+	}
+
+	origins, err := clientApp.ListOrigins(ctx, &contracts.ListOptions{}, conf.Application.Id)
+	if err != nil {
+		return utils.WrapError(msg.ErrorAdoptOrigin, err)
+	}
+	if len(origins.Results) > 0 {
+		origin := origins.Results[0]
+		conf.Origin.Id = origin.OriginId
+		conf.Origin.Name = origin.Name
+		conf.Origin.Address = nil
+		for _, address := range origin.Addresses {
+			conf.Origin.Address = append(conf.Origin.Address, address.Address)
+		}
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.LinkAdoptedResource, "origin", origin.Name, origin.OriginId))
+	}
+
+	return nil
+}
+
+// adoptFunction uses the edge function sent with --function-id or the one of the first instance of the application
+func (cmd *LinkCmd) adoptFunction(ctx context.Context, clientApp *apiapp.Client, info *LinkInfo, conf *contracts.AzionApplicationOptions) error {
+	functionID := info.FunctionID
+	if functionID == 0 {
+		instances, err := clientApp.EdgeFuncInstancesList(ctx, &contracts.ListOptions{Page: 1, PageSize: adoptPageSize}, conf.Application.Id)
+		if err != nil {
+			return utils.WrapError(msg.ErrorAdoptFunction, err)
+		}
+		if len(instances.Results) == 0 {
+			logger.Debug("The edge application has no edge function instances")
+			return nil
+		}
+		functionID = instances.Results[0].EdgeFunctionId
+	}
+
+	f := cmd.F
+	clientFunc := apifunc.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+	function, err := clientFunc.Get(ctx, functionID)
+	if err != nil {
+		return utils.WrapError(msg.ErrorAdoptFunction, err)
+	}
+	conf.Function.Id = function.GetId()
+	conf.Function.Name = function.GetName()
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.LinkAdoptedResource, "edge function", function.GetName(), function.GetId()))
+
+	return nil
+}
+
+// adoptDomain uses the domain sent with --domain-id or the first domain that points to the application
+func (cmd *LinkCmd) adoptDomain(ctx context.Context, info *LinkInfo, conf *contracts.AzionApplicationOptions) error {
+	f := cmd.F
+	clientDom := apidom.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
+
+	if info.DomainID != 0 {
+		domain, err := clientDom.Get(ctx, strconv.FormatInt(info.DomainID, 10))
+		if err != nil {
+			return utils.WrapError(msg.ErrorAdoptDomain, err)
+		}
+		conf.Domain.Id = domain.GetId()
+		conf.Domain.Name = domain.GetName()
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.LinkAdoptedResource, "domain", domain.GetName(), domain.GetId()))
+		return nil
+	}
+
+	for page := int64(1); ; page++ {
+		domains, err := clientDom.List(ctx, &contracts.ListOptions{Page: page, PageSize: adoptPageSize})
+		if err != nil {
+			return utils.WrapError(msg.ErrorAdoptDomain, err)
+		}
+
+		for _, domain := range domains.Results {
+			if domain.EdgeApplicationId == conf.Application.Id {
+				conf.Domain.Id = domain.Id
+				conf.Domain.Name = domain.Name
+				logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.LinkAdoptedResource, "domain", domain.Name, domain.Id))
+				return nil
+			}
+		}
+
+		if page >= domains.TotalPages {
+			logger.Debug("No domain points to the edge application")
+			return nil
+		}
+	}
+}
+
+// pickApplication asks the user to choose one of the edge applications of the account
+func (cmd *LinkCmd) pickApplication(ctx context.Context, client *apiapp.Client) (int64, error) {
+	var options []string
+	ids := map[string]int64{}
+
+	for page := int64(1); ; page++ {
+		resp, err := client.List(ctx, &contracts.ListOptions{Page: page, PageSize: adoptPageSize})
+		if err != nil {
+			return 0, utils.WrapError(msg.ErrorAdoptApplication, err)
+		}
+
+		for _, app := range resp.Results {
+			option := fmt.Sprintf("%s (%d)", app.Name, app.Id)
+			options = append(options, option)
+			ids[option] = app.Id
+		}
+
+		if page >= resp.TotalPages {
+			break
+		}
+	}
+
+	if len(options) == 0 {
+		return 0, msg.ErrorNoApplications
+	}
+
+	answer, err := cmd.PickApplication(msg.LinkAskApplication, options)
+	if err != nil {
+		return 0, err
+	}
+
+	return ids[answer], nil
+}
+
+func pickApplication(message string, options []string) (string, error) {
+	answer := ""
+	prompt := &survey.Select{
+		Message: message,
+		Options: options,
+	}
+	err := survey.AskOne(prompt, &answer)
+	return answer, err
+}
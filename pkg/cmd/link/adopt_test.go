@@ -0,0 +1,79 @@
+package link
+
+import (
+	"encoding/json"
+	"io/fs"
+	"os"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestAdoptResources(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("fills azion.json with the existing resources", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "edge_applications/1673635841"),
+			httpmock.JSONFromFile("./fixtures/application.json"),
+		)
+		mock.Register(
+			httpmock.REST("GET", "edge_applications/1673635841/functions_instances"),
+			httpmock.JSONFromFile("./fixtures/instances.json"),
+		)
+		mock.Register(
+			httpmock.REST("GET", "edge_functions/1337"),
+			httpmock.JSONFromFile("./fixtures/function.json"),
+		)
+		mock.Register(
+			httpmock.REST("GET", "domains"),
+			httpmock.JSONFromFile("./fixtures/domains.json"),
+		)
+		mock.Register(
+			httpmock.REST("GET", "edge_applications/1673635841/origins"),
+			httpmock.JSONFromFile("./fixtures/origins.json"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		linkCmd := NewLinkCmd(f)
+		linkCmd.Mkdir = func(path string, perm os.FileMode) error {
+			return nil
+		}
+
+		var written []byte
+		linkCmd.WriteFile = func(filename string, data []byte, perm fs.FileMode) error {
+			written = data
+			return nil
+		}
+
+		info := &LinkInfo{Name: "SUUPA_DOOPA", Preset: "vue", Mode: "deliver", ApplicationID: 1673635841}
+		require.NoError(t, linkCmd.createTemplateAzion(info))
+
+		conf := contracts.AzionApplicationOptions{}
+		require.NoError(t, json.Unmarshal(written, &conf))
+		require.EqualValues(t, 1673635841, conf.Application.Id)
+		require.Equal(t, "ssass", conf.Application.Name)
+		require.EqualValues(t, 1337, conf.Function.Id)
+		require.Equal(t, "SUUPA_FUNCTION", conf.Function.Name)
+		require.EqualValues(t, 1674060237, conf.Domain.Id)
+		require.Equal(t, "oite", conf.Domain.Name)
+		require.EqualValues(t, 88144, conf.Origin.Id)
+		require.Equal(t, []string{"www.new.api"}, conf.Origin.Address)
+	})
+
+	t.Run("adopt without input needs the application id", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		f, _, _ := testutils.NewFactory(mock)
+		f.NoInput = true
+		linkCmd := NewLinkCmd(f)
+
+		err := linkCmd.adoptResources(&LinkInfo{Adopt: true}, &contracts.AzionApplicationOptions{})
+		require.ErrorContains(t, err, "--application-id")
+	})
+}
@@ -0,0 +1,163 @@
+package link
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/link"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"go.uber.org/zap"
+)
+
+// Detection is a preset and mode proposed from the files found in the project
+type Detection struct {
+	Preset     string
+	Mode       string
+	Confidence string
+	Reasons    []string
+}
+
+// framework describes how a preset is recognized: by its dependencies in package.json and by its config files
+type framework struct {
+	preset       string
+	mode         string
+	dependencies []string
+	configFiles  []string
+	// requires is a dependency that must be present for the config files alone to be a match
+	requires string
+}
+
+var configExtensions = []string{".js", ".mjs", ".cjs", ".ts", ".mts"}
+
+// frameworks are checked in order, so more specific presets come first
+var frameworks = []framework{
+	{preset: "nextjs", dependencies: []string{"next"}, configFiles: withExtensions("next.config")},
+	{preset: "astro", mode: "deliver", dependencies: []string{"astro"}, configFiles: withExtensions("astro.config")},
+	{preset: "angular", mode: "deliver", dependencies: []string{"@angular/core"}, configFiles: []string{"angular.json"}},
+	{preset: "hexo", mode: "deliver", dependencies: []string{"hexo"}, configFiles: []string{"_config.yml"}, requires: "hexo"},
+	{preset: "vue", mode: "deliver", dependencies: []string{"vue"}, configFiles: withExtensions("vite.config"), requires: "vue"},
+	{preset: "react", mode: "deliver", dependencies: []string{"react-scripts", "react"}, configFiles: withExtensions("vite.config"), requires: "react"},
+}
+
+func withExtensions(name string) []string {
+	files := make([]string, 0, len(configExtensions))
+	for _, ext := range configExtensions {
+		files = append(files, name+ext)
+	}
+	return files
+}
+
+type packageJson struct {
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+}
+
+// detectFramework inspects package.json, lockfiles and well-known config files in dir
+// and proposes a preset and mode. It returns nil when no framework is recognized
+func (cmd *LinkCmd) detectFramework(dir string) *Detection {
+	deps := map[string]bool{}
+	if data, err := cmd.FileReader(filepath.Join(dir, "package.json")); err == nil {
+		pkg := packageJson{}
+		if err := json.Unmarshal(data, &pkg); err != nil {
+			logger.Debug("Error while parsing package.json", zap.Error(err))
+		}
+		for name := range pkg.Dependencies {
+			deps[name] = true
+		}
+		for name := range pkg.DevDependencies {
+			deps[name] = true
+		}
+	}
+
+	lockfile, _ := utils.FindLockfile(dir, cmd.Stat)
+
+	for _, fw := range frameworks {
+		var reasons []string
+		score := 0
+
+		for _, dep := range fw.dependencies {
+			if deps[dep] {
+				reasons = append(reasons, fmt.Sprintf(msg.LinkDetectReasonDependency, dep))
+				score++
+				break
+			}
+		}
+
+		for _, file := range fw.configFiles {
+			if _, err := cmd.Stat(filepath.Join(dir, file)); err == nil {
+				if fw.requires == "" || deps[fw.requires] {
+					reasons = append(reasons, fmt.Sprintf(msg.LinkDetectReasonConfig, file))
+					score++
+				}
+				break
+			}
+		}
+
+		if score == 0 {
+			continue
+		}
+
+		// a lockfile means the dependencies above are actually installed by the project
+		if lockfile != "" && deps[fw.dependencies[0]] {
+			reasons = append(reasons, fmt.Sprintf(msg.LinkDetectReasonLockfile, lockfile))
+		}
+
+		confidence := msg.LinkDetectConfidenceMedium
+		if score > 1 {
+			confidence = msg.LinkDetectConfidenceHigh
+		}
+
+		return &Detection{
+			Preset:     fw.preset,
+			Mode:       fw.mode,
+			Confidence: confidence,
+			Reasons:    reasons,
+		}
+	}
+
+	return nil
+}
+
+// proposeFramework fills the preset and mode with the detected framework, if the user accepts it.
+// With --auto or --yes the proposal is accepted without prompting
+func (cmd *LinkCmd) proposeFramework(info *LinkInfo) error {
+	detection := cmd.detectFramework(info.PathWorkingDir)
+	if detection == nil {
+		logger.Debug("No framework detected in " + info.PathWorkingDir)
+		return nil
+	}
+
+	description := detection.Preset
+	if detection.Mode != "" {
+		description = fmt.Sprintf("%s (%s)", detection.Preset, detection.Mode)
+	}
+	logger.FInfo(cmd.Io.Out, fmt.Sprintf(msg.LinkDetected, description, detection.Confidence))
+	for _, reason := range detection.Reasons {
+		logger.FInfo(cmd.Io.Out, fmt.Sprintf("  - %s\n", reason))
+	}
+
+	accept := info.Auto || info.GlobalFlagAll
+	if !accept {
+		if !cmd.F.CanPrompt() {
+			return nil
+		}
+
+		var err error
+		accept, err = cmd.AcceptDetection(info, fmt.Sprintf(msg.LinkAskUseDetected, description))
+		if err != nil {
+			return err
+		}
+	}
+
+	if accept {
+		info.Preset = detection.Preset
+		if info.Mode == "" {
+			info.Mode = strings.ToLower(detection.Mode)
+		}
+	}
+
+	return nil
+}
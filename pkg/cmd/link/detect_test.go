@@ -0,0 +1,142 @@
+package link
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/link"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestDetectFramework(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	tests := []struct {
+		name       string
+		files      map[string]string
+		preset     string
+		mode       string
+		confidence string
+		reasons    []string
+	}{
+		{
+			name: "next with config file",
+			files: map[string]string{
+				"package.json":      `{"dependencies": {"next": "13.0.0", "react": "18.0.0"}}`,
+				"next.config.js":    ``,
+				"package-lock.json": `{}`,
+			},
+			preset:     "nextjs",
+			confidence: msg.LinkDetectConfidenceHigh,
+			reasons:    []string{"package.json depends on 'next'", "config file 'next.config.js' found", "dependencies are locked in 'package-lock.json'"},
+		},
+		{
+			name: "astro dependency only",
+			files: map[string]string{
+				"package.json": `{"devDependencies": {"astro": "2.0.0"}}`,
+			},
+			preset:     "astro",
+			mode:       "deliver",
+			confidence: msg.LinkDetectConfidenceMedium,
+		},
+		{
+			name: "vue with vite",
+			files: map[string]string{
+				"package.json":   `{"dependencies": {"vue": "3.0.0"}}`,
+				"vite.config.ts": ``,
+			},
+			preset:     "vue",
+			mode:       "deliver",
+			confidence: msg.LinkDetectConfidenceHigh,
+		},
+		{
+			name: "hexo config without dependency is ignored",
+			files: map[string]string{
+				"_config.yml": ``,
+			},
+		},
+		{
+			name:  "empty project",
+			files: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const dir = "/project"
+
+			mock := &httpmock.Registry{}
+			f, _, _ := testutils.NewFactory(mock)
+			linkCmd := NewLinkCmd(f)
+			linkCmd.FileReader = func(path string) ([]byte, error) {
+				if content, ok := tt.files[strings.TrimPrefix(path, dir+"/")]; ok {
+					return []byte(content), nil
+				}
+				return nil, os.ErrNotExist
+			}
+			linkCmd.Stat = func(path string) (fs.FileInfo, error) {
+				if _, ok := tt.files[strings.TrimPrefix(path, dir+"/")]; ok {
+					return nil, nil
+				}
+				return nil, os.ErrNotExist
+			}
+
+			detection := linkCmd.detectFramework(dir)
+			if tt.preset == "" {
+				require.Nil(t, detection)
+				return
+			}
+
+			require.NotNil(t, detection)
+			require.Equal(t, tt.preset, detection.Preset)
+			require.Equal(t, tt.mode, detection.Mode)
+			require.Equal(t, tt.confidence, detection.Confidence)
+			if tt.reasons != nil {
+				require.Equal(t, tt.reasons, detection.Reasons)
+			}
+		})
+	}
+}
+
+func TestProposeFramework(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("accepted with auto", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"astro": "2.0.0"}}`), 0644))
+
+		mock := &httpmock.Registry{}
+		f, stdout, _ := testutils.NewFactory(mock)
+		linkCmd := NewLinkCmd(f)
+
+		info := &LinkInfo{PathWorkingDir: dir, Auto: true}
+		require.NoError(t, linkCmd.proposeFramework(info))
+		require.Equal(t, "astro", info.Preset)
+		require.Equal(t, "deliver", info.Mode)
+		require.Contains(t, stdout.String(), "Detected preset astro (deliver)")
+	})
+
+	t.Run("declined keeps the preset empty", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"dependencies": {"astro": "2.0.0"}}`), 0644))
+
+		mock := &httpmock.Registry{}
+		f, _, _ := testutils.NewFactory(mock)
+		f.IOStreams.SetStdinTTY(true)
+		linkCmd := NewLinkCmd(f)
+		linkCmd.AcceptDetection = func(info *LinkInfo, msg string) (bool, error) {
+			return false, nil
+		}
+
+		info := &LinkInfo{PathWorkingDir: dir}
+		require.NoError(t, linkCmd.proposeFramework(info))
+		require.Empty(t, info.Preset)
+	})
+}
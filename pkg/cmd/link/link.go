@@ -28,6 +28,14 @@ type LinkInfo struct {
 	PathWorkingDir string
 	GlobalFlagAll  bool
 	Auto           bool
+	PackageManager string
+	InstallDeps    *bool
+	StartDev       *bool
+	Deploy         *bool
+	Adopt          bool
+	ApplicationID  int64
+	DomainID       int64
+	FunctionID     int64
 }
 
 type LinkCmd struct {
@@ -50,6 +58,8 @@ type LinkCmd struct {
 	CommandRunInteractive func(f *cmdutil.Factory, comm string) error
 	ShouldConfigure       func(info *LinkInfo) (bool, error)
 	ShouldDevDeploy       func(info *LinkInfo, msg string) (bool, error)
+	AcceptDetection       func(info *LinkInfo, msg string) (bool, error)
+	PickApplication       func(msg string, options []string) (string, error)
 	DeployCmd             func(f *cmdutil.Factory) *deploy.DeployCmd
 	DevCmd                func(f *cmdutil.Factory) *dev.DevCmd
 	F                     *cmdutil.Factory
@@ -57,24 +67,28 @@ type LinkCmd struct {
 
 func NewLinkCmd(f *cmdutil.Factory) *LinkCmd {
 	return &LinkCmd{
-		Io:              f.IOStreams,
-		F:               f,
-		GetWorkDir:      utils.GetWorkingDir,
-		FileReader:      os.ReadFile,
-		LookPath:        exec.LookPath,
-		IsDirEmpty:      utils.IsDirEmpty,
-		CleanDir:        utils.CleanDirectory,
-		WriteFile:       os.WriteFile,
-		OpenFile:        os.Open,
-		RemoveAll:       os.RemoveAll,
-		Rename:          os.Rename,
-		CreateTempDir:   os.MkdirTemp,
-		EnvLoader:       utils.LoadEnvVarsFromFile,
-		Stat:            os.Stat,
-		Mkdir:           os.MkdirAll,
-		GitPlainClone:   git.PlainClone,
-		ShouldConfigure: shouldConfigure,
+		Io:            f.IOStreams,
+		F:             f,
+		GetWorkDir:    utils.GetWorkingDir,
+		FileReader:    os.ReadFile,
+		LookPath:      exec.LookPath,
+		IsDirEmpty:    utils.IsDirEmpty,
+		CleanDir:      utils.CleanDirectory,
+		WriteFile:     os.WriteFile,
+		OpenFile:      os.Open,
+		RemoveAll:     os.RemoveAll,
+		Rename:        os.Rename,
+		CreateTempDir: os.MkdirTemp,
+		EnvLoader:     utils.LoadEnvVarsFromFile,
+		Stat:          os.Stat,
+		Mkdir:         os.MkdirAll,
+		GitPlainClone: git.PlainClone,
+		ShouldConfigure: func(info *LinkInfo) (bool, error) {
+			return shouldConfigure(f, info)
+		},
 		ShouldDevDeploy: shouldDevDeploy,
+		AcceptDetection: shouldDevDeploy,
+		PickApplication: pickApplication,
 		DevCmd:          dev.NewDevCmd,
 		DeployCmd:       deploy.NewDeployCmd,
 		CommandRunner: func(f *cmdutil.Factory, comm string, envVars []string) (string, error) {
@@ -100,11 +114,18 @@ func NewCobraCmd(link *LinkCmd, f *cmdutil.Factory) *cobra.Command {
 		$ azion link --help
 		$ azion link --name "thisisatest" --preset hexo --mode deliver
 		$ azion link --preset astro --mode deliver
+		$ azion link --auto
+		$ azion link --adopt
+		$ azion link --name "thisisatest" --preset vue --mode deliver --application-id 1673635841
 		$ azion link --name "thisisatest" --preset nextjs
 		$ azion link --name "thisisatest" --preset static
+		$ azion link --no-input --name "thisisatest" --preset vue --mode deliver --install-deps --package-manager npm --start-dev=false --deploy
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			info.GlobalFlagAll = f.GlobalFlagAll
+			info.InstallDeps = cmdutil.BoolFlag(cmd, "install-deps")
+			info.StartDev = cmdutil.BoolFlag(cmd, "start-dev")
+			info.Deploy = cmdutil.BoolFlag(cmd, "deploy")
 			return link.run(info, options)
 		},
 	}
@@ -113,6 +134,14 @@ func NewCobraCmd(link *LinkCmd, f *cmdutil.Factory) *cobra.Command {
 	cobraCmd.Flags().StringVar(&info.Preset, "preset", "", msg.EdgeApplicationsLinkFlagTemplate)
 	cobraCmd.Flags().StringVar(&info.Mode, "mode", "", msg.EdgeApplicationsLinkFlagMode)
 	cobraCmd.Flags().BoolVar(&info.Auto, "auto", false, msg.LinkFlagAuto)
+	cobraCmd.Flags().StringVar(&info.PackageManager, "package-manager", "", msg.LinkFlagPackageManager)
+	cobraCmd.Flags().Bool("install-deps", false, msg.LinkFlagInstallDeps)
+	cobraCmd.Flags().Bool("start-dev", false, msg.LinkFlagStartDev)
+	cobraCmd.Flags().Bool("deploy", false, msg.LinkFlagDeploy)
+	cobraCmd.Flags().BoolVar(&info.Adopt, "adopt", false, msg.LinkFlagAdopt)
+	cobraCmd.Flags().Int64Var(&info.ApplicationID, "application-id", 0, msg.LinkFlagApplicationID)
+	cobraCmd.Flags().Int64Var(&info.DomainID, "domain-id", 0, msg.LinkFlagDomainID)
+	cobraCmd.Flags().Int64Var(&info.FunctionID, "function-id", 0, msg.LinkFlagFunctionID)
 
 	return cobraCmd
 }
@@ -139,6 +168,12 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 		return nil
 	}
 
+	if info.Preset == "" {
+		if err := cmd.proposeFramework(info); err != nil {
+			return err
+		}
+	}
+
 	switch info.Preset {
 	case "simple":
 		return initSimple(cmd, path, info)
@@ -158,6 +193,9 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 		} else {
 			// if name was not sent we ask for input, otherwise info.Name already has the value
 			if info.Name == "" {
+				if !cmd.F.CanPrompt() {
+					return fmt.Errorf(utils.ErrorMissingFlag.Error(), "name")
+				}
 				projName, err := askForInput(msg.LinkProjectQuestion, thoth.GenerateName())
 				if err != nil {
 					return err
@@ -180,28 +218,19 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 		logger.FInfo(cmd.Io.Out, msg.WebAppLinkCmdSuccess)
 
 		if !info.Auto {
-			shouldDev, err := cmd.ShouldDevDeploy(info, "Do you want to start a local development server?")
-			if err != err {
+			deps := cmd.depsInstaller(info)
+			shouldDev, err := utils.Confirm(cmd.F, info.StartDev, "start-dev", func() (bool, error) {
+				return cmd.ShouldDevDeploy(info, msg.LinkAskStartDev)
+			})
+			if err != nil {
 				return err
 			}
 			if shouldDev {
-				shouldDeps, err := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to start local development server")
-				if err != err {
+				err = deps.Install(msg.LinkAskInstallDepsDev)
+				if err != nil {
 					return err
 				}
 
-				if shouldDeps {
-					answer, err := utils.GetPackageManager()
-					if err != nil {
-						return err
-					}
-					err = depsInstall(cmd, answer)
-					if err != nil {
-						logger.Debug("Error while installing project dependencies", zap.Error(err))
-						return msg.ErrorDeps
-					}
-				}
-
 				logger.Debug("Running dev command from link command")
 				dev := cmd.DevCmd(cmd.F)
 				err = dev.Run(cmd.F)
@@ -213,28 +242,18 @@ func (cmd *LinkCmd) run(info *LinkInfo, options *contracts.AzionApplicationOptio
 				logger.FInfo(cmd.Io.Out, msg.LinkDevCommand)
 			}
 
-			shouldDeploy, err := cmd.ShouldDevDeploy(info, "Do you want to deploy your project?")
-			if err != err {
+			shouldDeploy, err := utils.Confirm(cmd.F, info.Deploy, "deploy", func() (bool, error) {
+				return cmd.ShouldDevDeploy(info, msg.LinkAskDeploy)
+			})
+			if err != nil {
 				return err
 			}
 			if shouldDeploy {
-				shouldYarn, err := cmd.ShouldDevDeploy(info, "Do you want to install project dependencies? This may be required to deploy the project")
-				if err != err {
+				err = deps.Install(msg.LinkAskInstallDepsDeploy)
+				if err != nil {
 					return err
 				}
 
-				if shouldYarn {
-					answer, err := utils.GetPackageManager()
-					if err != nil {
-						return err
-					}
-					err = depsInstall(cmd, answer)
-					if err != nil {
-						logger.Debug("Error while installing project dependencies", zap.Error(err))
-						return msg.ErrorDeps
-					}
-				}
-
 				logger.Debug("Running deploy command from link command")
 				deploy := cmd.DeployCmd(cmd.F)
 				err = deploy.Run(cmd.F)
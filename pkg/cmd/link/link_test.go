@@ -2,6 +2,7 @@ package link
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -14,6 +15,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/link"
 	"github.com/aziontech/azion-cli/pkg/httpmock"
 	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/go-git/go-git/v5"
 
 	"github.com/stretchr/testify/require"
@@ -102,4 +104,44 @@ func TestCobraCmd(t *testing.T) {
 		require.NoError(t, err)
 		require.Contains(t, stdout.String(), fmt.Sprintf(msg.EdgeApplicationsLinkSuccessful+"\n", "SUUPA_DOOPA"))
 	})
+
+	t.Run("no input fails naming the missing flag", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		f, _, _ := testutils.NewFactory(mock)
+		linkCmd := NewLinkCmd(f)
+
+		linkCmd.ShouldConfigure = func(info *LinkInfo) (bool, error) {
+			return true, nil
+		}
+
+		cmd := NewCobraCmd(linkCmd, f)
+		cmd.SetArgs([]string{"--name", "SUUPA_DOOPA", "--preset", "vue"})
+
+		err := cmd.Execute()
+
+		require.EqualError(t, err, fmt.Sprintf(utils.ErrorMissingFlag.Error(), "mode"))
+	})
+
+	t.Run("previous configuration without --yes", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		f, _, _ := testutils.NewFactory(mock)
+		linkCmd := NewLinkCmd(f)
+
+		linkCmd.ShouldConfigure = func(info *LinkInfo) (bool, error) {
+			return true, nil
+		}
+		linkCmd.IsDirEmpty = func(dirpath string) (bool, error) {
+			return false, nil
+		}
+		linkCmd.CleanDir = func(dirpath string) error {
+			return errors.New("unexpected clean")
+		}
+
+		cmd := NewCobraCmd(linkCmd, f)
+		cmd.SetArgs([]string{"--auto", "--preset", "vue", "--mode", "deliver"})
+
+		err := cmd.Execute()
+
+		require.EqualError(t, err, fmt.Sprintf(utils.ErrorMissingFlag.Error(), "yes"))
+	})
 }
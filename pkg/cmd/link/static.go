@@ -6,6 +6,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/link"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
 	thoth "github.com/aziontech/go-thoth"
 )
 
@@ -20,6 +21,9 @@ func initStatic(cmd *LinkCmd, info *LinkInfo, options *contracts.AzionApplicatio
 			info.Name = thoth.GenerateName()
 		} else {
 			if info.Name == "" {
+				if !cmd.F.CanPrompt() {
+					return fmt.Errorf(utils.ErrorMissingFlag.Error(), "name")
+				}
 				projName, err := askForInput(msg.LinkProjectQuestion, thoth.GenerateName())
 				if err != nil {
 					return err
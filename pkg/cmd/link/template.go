@@ -31,6 +31,12 @@ func (cmd *LinkCmd) createTemplateAzion(info *LinkInfo) error {
 	azionJson.Origin.Name = "__DEFAULT__"
 	azionJson.RtPurge.PurgeOnPublish = true
 
+	if info.Adopt || info.ApplicationID != 0 || info.DomainID != 0 || info.FunctionID != 0 {
+		if err := cmd.adoptResources(info, azionJson); err != nil {
+			return err
+		}
+	}
+
 	return cmd.createJsonFile(azionJson, info)
 
 }
@@ -6,25 +6,26 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 	msg "github.com/aziontech/azion-cli/messages/init"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	vul "github.com/aziontech/azion-cli/pkg/vulcan"
+	"github.com/aziontech/azion-cli/utils"
 	"go.uber.org/zap"
 )
 
-func shouldConfigure(info *LinkInfo) (bool, error) {
-	if info.GlobalFlagAll || info.Auto {
-		return true, nil
-	}
-	var shouldConfigure bool
-	msg := fmt.Sprintf("Do you want to link %s to Azion?", info.PathWorkingDir)
-	prompt := &survey.Confirm{
-		Message: msg,
-	}
-	err := survey.AskOne(prompt, &shouldConfigure)
-	if err != nil {
-		return false, err
-	}
-	return shouldConfigure, nil
+func shouldConfigure(f *cmdutil.Factory, info *LinkInfo) (bool, error) {
+	return utils.Confirm(f, nil, "yes", func() (bool, error) {
+		var shouldConfigure bool
+		msg := fmt.Sprintf("Do you want to link %s to Azion?", info.PathWorkingDir)
+		prompt := &survey.Confirm{
+			Message: msg,
+		}
+		err := survey.AskOne(prompt, &shouldConfigure)
+		if err != nil {
+			return false, err
+		}
+		return shouldConfigure, nil
+	})
 }
 
 func shouldDevDeploy(info *LinkInfo, msg string) (bool, error) {
@@ -43,19 +44,17 @@ func shouldDevDeploy(info *LinkInfo, msg string) (bool, error) {
 }
 
 func shouldFetch(cmd *LinkCmd, info *LinkInfo) (bool, error) {
-	var err error
-	var shouldFetchTemplates bool
 	if empty, _ := cmd.IsDirEmpty("./azion"); !empty {
-		if info.GlobalFlagAll || info.Auto {
-			shouldFetchTemplates = true
-		} else {
+		shouldFetchTemplates, err := utils.Confirm(cmd.F, nil, "yes", func() (bool, error) {
+			var shouldFetchTemplates bool
 			prompt := &survey.Confirm{
 				Message: "This project was already configured. Do you want to override the previous configuration?",
 			}
 			err := survey.AskOne(prompt, &shouldFetchTemplates)
-			if err != nil {
-				return false, err
-			}
+			return shouldFetchTemplates, err
+		})
+		if err != nil {
+			return false, err
 		}
 
 		if shouldFetchTemplates {
@@ -70,6 +69,23 @@ func shouldFetch(cmd *LinkCmd, info *LinkInfo) (bool, error) {
 	return true, nil
 }
 
+// depsInstaller returns the installer of the project dependencies, which asks the questions with ShouldDevDeploy
+func (cmd *LinkCmd) depsInstaller(info *LinkInfo) *utils.DepsInstaller {
+	return &utils.DepsInstaller{
+		F:              cmd.F,
+		Dir:            info.PathWorkingDir,
+		PackageManager: info.PackageManager,
+		Flag:           info.InstallDeps,
+		LookPath:       cmd.LookPath,
+		RunInteractive: cmd.CommandRunInteractive,
+		Stat:           cmd.Stat,
+		FileReader:     cmd.FileReader,
+		Ask: func(question string) (bool, error) {
+			return cmd.ShouldDevDeploy(info, question)
+		},
+	}
+}
+
 func askForInput(msg string, defaultIn string) (string, error) {
 	var userInput string
 	prompt := &survey.Input{
@@ -90,6 +106,13 @@ func (cmd *LinkCmd) selectVulcanMode(info *LinkInfo) error {
 		return nil
 	}
 
+	if !cmd.F.CanPrompt() {
+		if info.Preset == "" {
+			return fmt.Errorf(utils.ErrorMissingFlag.Error(), "preset")
+		}
+		return fmt.Errorf(utils.ErrorMissingFlag.Error(), "mode")
+	}
+
 	logger.FInfo(cmd.Io.Out, msg.InitGettingTemplates)
 
 	command := vul.Command("--loglevel=error --no-update-notifier", "presets ls")
@@ -123,15 +146,3 @@ func (cmd *LinkCmd) selectVulcanMode(info *LinkInfo) error {
 
 	return nil
 }
-
-func depsInstall(cmd *LinkCmd, packageManager string) error {
-	logger.FInfo(cmd.Io.Out, msg.InitInstallDeps)
-	command := fmt.Sprintf("%s install", packageManager)
-	err := cmd.CommandRunInteractive(cmd.F, command)
-	if err != nil {
-		logger.Debug("Error while running command with simultaneous output", zap.Error(err))
-		return msg.ErrorDeps
-	}
-
-	return nil
-}
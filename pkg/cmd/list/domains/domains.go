@@ -2,18 +2,13 @@ package domains
 
 import (
 	"context"
-	"strings"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/list/domains"
 	api "github.com/aziontech/azion-cli/pkg/api/domains"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/aziontech/azion-cli/pkg/logger"
-	"github.com/aziontech/azion-cli/utils"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/spf13/cobra"
 )
 
@@ -27,9 +22,21 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
 		$ azion list domains
 		$ azion list domains --details
+		$ azion list domains --format yaml
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if err := PrintTable(cmd, f, opts); err != nil {
+			headers := []string{"ID", "NAME"}
+			if opts.Details {
+				headers = []string{"ID", "NAME", "EDGE DOMAIN", "DIGITAL CERTIFICATE ID", "EDGE APPLICATION ID", "CNAME ACCESS ONLY", "CNAMES", "ACTIVE"}
+			}
+
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+			if err != nil {
+				return err
+			}
+			p.NoHeader = opts.Page > 1
+
+			if err := PrintTable(cmd, f, opts, p); err != nil {
 				return msg.ErrorGetDomains
 			}
 			return nil
@@ -37,11 +44,12 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.DomainsListHelpFlag)
 	return cmd
 }
 
-func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions, p *printer.Printer) error {
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
 
@@ -51,21 +59,11 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 			return err
 		}
 
-		tbl := table.New("ID", "NAME")
-		tbl.WithWriter(f.IOStreams.Out)
-
-		if opts.Details {
-			tbl = table.New("ID", "NAME", "EDGE DOMAIN", "DIGITAL CERTIFICATE ID", "EDGE APPLICATION ID", "CNAME ACCESS ONLY", "CNAMES", "ACTIVE")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
 		for _, v := range resp.Results {
-			tbl.AddRow(
+			p.AddRow(
+				v,
 				v.Id,
-				utils.TruncateString(v.Name),
+				v.Name,
 				v.DomainName,
 				v.DigitalCertificateId,
 				v.EdgeApplicationId,
@@ -75,16 +73,8 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 			)
 		}
 
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
+		if err := p.Flush(); err != nil {
+			return err
 		}
 
 		if opts.Page >= resp.TotalPages {
@@ -93,5 +83,5 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		opts.Page++
 	}
 
-	return nil
+	return p.Close()
 }
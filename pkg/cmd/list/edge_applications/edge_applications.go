@@ -2,14 +2,8 @@ package edge_applications
 
 import (
 	"context"
-	"fmt"
-	"github.com/aziontech/azion-cli/pkg/logger"
-	"strings"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	"github.com/aziontech/azion-cli/messages/general"
 	msg "github.com/aziontech/azion-cli/messages/list/edge_applications"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
@@ -32,6 +26,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		$ azion list edge-application --details
 		$ azion list edge-application --page 1 
 		$ azion list edge-application --page_size 5
+		$ azion list edge-application --format json
+		$ azion list edge-application --format csv --out ./applications.csv
+		$ azion list edge-application --fields id,name,active
+		$ azion list edge-application --query '#(active==true)#.name'
 		`),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			client := api.NewClient(f.HttpClient,
@@ -40,7 +38,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			)
 
 			if err := PrintTable(client, f, opts); err != nil {
-				return fmt.Errorf(msg.ErrorGetAll.Error(), err)
+				return utils.WrapError(msg.ErrorGetAll, err)
 			}
 			return nil
 		},
@@ -50,6 +48,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	flags.Int64Var(&opts.Page, "page", 1, general.ApiListFlagPage)
 	flags.Int64Var(&opts.PageSize, "page_size", 10, general.ApiListFlagPageSize)
 	flags.BoolVar(&opts.Details, "details", false, general.ApiListFlagDetails)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	flags.BoolP("help", "h", false, msg.ListHelpFlag)
 	return cmd
 }
@@ -57,27 +56,28 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions) error {
 	c := context.Background()
 
+	headers := []string{"ID", "NAME", "ACTIVE"}
+	if opts.Details {
+		headers = []string{"ID", "NAME", "DEBUG RULES", "LAST EDITOR", "LAST MODIFIED", "ACTIVE"}
+	}
+
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+	if err != nil {
+		return err
+	}
+	p.NoHeader = opts.Page > 1
+
 	for {
 		resp, err := client.List(c, opts)
 		if err != nil {
 			return err
 		}
 
-		tbl := table.New("ID", "NAME", "ACTIVE")
-		tbl.WithWriter(f.IOStreams.Out)
-
-		if opts.Details {
-			tbl = table.New("ID", "NAME", "DEBUG RULES", "LAST EDITOR", "LAST MODIFIED", "ACTIVE")
-		}
-
-		headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-		columnFmt := color.New(color.FgGreen).SprintfFunc()
-		tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
 		for _, v := range resp.Results {
-			tbl.AddRow(
+			p.AddRow(
+				v,
 				v.Id,
-				utils.TruncateString(v.Name),
+				v.Name,
 				v.DebugRules,
 				v.LastEditor,
 				v.LastModified,
@@ -85,16 +85,8 @@ func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOpti
 			)
 		}
 
-		format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-		tbl.CalculateWidths([]string{})
-
-		// print the header only in the first flow
-		if opts.Page == 1 {
-			logger.PrintHeader(tbl, format)
-		}
-
-		for _, row := range tbl.GetRows() {
-			logger.PrintRow(tbl, format, row)
+		if err := p.Flush(); err != nil {
+			return err
 		}
 
 		if opts.Page >= resp.TotalPages {
@@ -103,5 +95,5 @@ func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOpti
 		opts.Page++
 	}
 
-	return nil
+	return p.Close()
 }
@@ -2,8 +2,10 @@ package edge_applications
 
 import (
 	"errors"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zapcore"
 
 	"github.com/aziontech/azion-cli/pkg/httpmock"
@@ -72,3 +74,21 @@ func TestNewCmd(t *testing.T) {
 		})
 	}
 }
+
+func TestInvalidOutputLeavesNoFile(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	for _, args := range [][]string{
+		{"--query", "#(active==true"},
+		{"--template", "{{.Name"},
+	} {
+		out := filepath.Join(t.TempDir(), "apps.json")
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+
+		cmd := NewCmd(f)
+		cmd.SetArgs(append(args, "--out", out))
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+		require.NoFileExists(t, out)
+	}
+}
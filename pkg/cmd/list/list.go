@@ -3,11 +3,18 @@ package list
 import (
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/list"
+	cacheSettings "github.com/aziontech/azion-cli/pkg/cmd/cache_settings/list"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/device_groups/list"
+	edgeFunctions "github.com/aziontech/azion-cli/pkg/cmd/edge_functions/list"
+	functionInstances "github.com/aziontech/azion-cli/pkg/cmd/edge_functions_instances/list"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/edge_services/list"
+	serviceResources "github.com/aziontech/azion-cli/pkg/cmd/edge_services/resources/list"
 	domains "github.com/aziontech/azion-cli/pkg/cmd/list/domains"
 	edgeApplications "github.com/aziontech/azion-cli/pkg/cmd/list/edge_applications"
 	token "github.com/aziontech/azion-cli/pkg/cmd/list/personal_token"
 	rule "github.com/aziontech/azion-cli/pkg/cmd/list/rule_engine"
-
+	origins "github.com/aziontech/azion-cli/pkg/cmd/origins/list"
+	variables "github.com/aziontech/azion-cli/pkg/cmd/variables/list"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -18,6 +25,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: msg.ShortDescription,
 		Long:  msg.LongDescription, Example: heredoc.Doc(`
 		$ azion list --help
+		$ azion list origin --application-id 1673635839
 		$ azion list edge-application
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -29,6 +37,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(rule.NewCmd(f))
 	cmd.AddCommand(domains.NewCmd(f))
 	cmd.AddCommand(token.NewCmd(f))
+	cmd.AddCommand(origins.NewCmd(f))
+	cmd.AddCommand(cacheSettings.NewCmd(f))
+	cmd.AddCommand(edgeFunctions.NewCmd(f))
+	cmd.AddCommand(functionInstances.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(serviceResources.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
@@ -2,24 +2,19 @@ package personaltoken
 
 import (
 	"context"
-	"fmt"
-	"strings"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	"github.com/aziontech/azion-cli/messages/general"
 	msg "github.com/aziontech/azion-cli/messages/list/personal_token"
 	api "github.com/aziontech/azion-cli/pkg/api/personal_token"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
-	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
-	var details bool
+	opts := &contracts.ListOptions{}
 
 	cmd := &cobra.Command{
 		Use:           msg.Usage,
@@ -29,6 +24,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
         $ azion list personal-token  
         $ azion list personal-token --details
+        $ azion list personal-token --format json
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := api.NewClient(f.HttpClient,
@@ -36,60 +32,53 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 				f.Config.GetString("token"),
 			)
 
-			if err := PrintTable(client, f, details); err != nil {
-				return fmt.Errorf(msg.ErrorList.Error(), err)
+			if err := PrintTable(client, f, opts); err != nil {
+				return utils.WrapError(msg.ErrorList, err)
 			}
 			return nil
 		},
 	}
 
 	flags := cmd.Flags()
-	flags.BoolVar(&details, "details", false, general.ApiListFlagDetails)
+	flags.BoolVar(&opts.Details, "details", false, general.ApiListFlagDetails)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	flags.BoolP("help", "h", false, msg.HelpFlag)
 	return cmd
 }
 
-func PrintTable(client *api.Client, f *cmdutil.Factory, details bool) error {
+func PrintTable(client *api.Client, f *cmdutil.Factory, opts *contracts.ListOptions) error {
 	c := context.Background()
 
-	resp, err := client.List(c)
+	headers := []string{"ID", "NAME", "EXPIRES AT"}
+	if opts.Details {
+		headers = []string{"ID", "NAME", "EXPIRES AT", "CREATED AT", "DESCRIPTION"}
+	}
+
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
 	if err != nil {
 		return err
 	}
 
-	tbl := table.New("ID", "NAME", "EXPIRES AT")
-	tbl.WithWriter(f.IOStreams.Out)
-
-	if details {
-		tbl = table.New("ID", "NAME", "EXPIRES AT", "CREATED AT", "DESCRIPTION")
+	resp, err := client.List(c)
+	if err != nil {
+		return err
 	}
 
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
 	for _, v := range resp {
 		var description string
 		if v.Description.Get() != nil {
 			description = *v.Description.Get()
 		}
 
-		tbl.AddRow(
+		p.AddRow(
+			v,
 			*v.Uuid,
-			utils.TruncateString(*v.Name),
+			*v.Name,
 			*v.ExpiresAt,
 			*v.Created,
-			utils.TruncateString(description),
+			description,
 		)
 	}
 
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	logger.PrintHeader(tbl, format)
-
-	for _, row := range tbl.GetRows() {
-		logger.PrintRow(tbl, format, row)
-	}
-
-	return nil
+	return p.Close()
 }
@@ -2,15 +2,11 @@ package ruleengine
 
 import (
 	"context"
-	"fmt"
 	"strconv"
-	"strings"
 
-	"github.com/fatih/color"
 	"go.uber.org/zap"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/list/rules_engine"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
@@ -33,6 +29,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true, Example: heredoc.Doc(`
 		$ azion list rules-engine --application-id 1673635839 --phase request
 		$ azion list rules-engine --application-id 1673635839 --phase response --details
+		$ azion list rules-engine --application-id 1673635839 --phase request --format csv
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -63,13 +60,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			if err := PrintTable(cmd, f, opts); err != nil {
-				return fmt.Errorf(msg.ErrorGetRulesEngines.Error(), err)
+				return utils.WrapError(msg.ErrorGetRulesEngines, err)
 			}
 			return nil
 		},
 	}
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.RulesEngineListHelpFlag)
 	cmd.Flags().Int64Var(&edgeApplicationID, "application-id", 0, msg.ApplicationFlagId)
 	cmd.Flags().StringVar(&phase, "phase", "request", msg.RulesEnginePhase)
@@ -77,6 +75,16 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 }
 
 func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+	headers := []string{"ID", "NAME"}
+	if cmd.Flags().Changed("details") {
+		headers = []string{"ID", "NAME", "ORDER", "PHASE", "ACTIVE"}
+	}
+
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+	if err != nil {
+		return err
+	}
+
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
 
@@ -85,27 +93,9 @@ func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOpti
 		return err
 	}
 
-	tbl := table.New("ID", "NAME")
-	table.DefaultWriter = f.IOStreams.Out
-	if cmd.Flags().Changed("details") {
-		tbl = table.New("ID", "NAME", "ORDER", "PHASE", "ACTIVE")
-	}
-
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-
 	for _, v := range rules.Results {
-		tbl.AddRow(v.Id, v.Name, v.Order, v.Phase, v.IsActive)
-	}
-
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	logger.PrintHeader(tbl, format)
-	for _, row := range tbl.GetRows() {
-		logger.PrintRow(tbl, format, row)
+		p.AddRow(v, v.Id, v.Name, v.Order, v.Phase, v.IsActive)
 	}
 
-	f.IOStreams.Out = table.DefaultWriter
-	return nil
+	return p.Close()
 }
@@ -0,0 +1,172 @@
+package login
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/login"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/iostreams"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+type LoginCmd struct {
+	Io            *iostreams.IOStreams
+	F             *cmdutil.Factory
+	AskToken      func(msg string) (string, error)
+	AskPassphrase func(msg string) (string, error)
+	NewToken      func(c *token.Config) (*token.Token, error)
+}
+
+func NewLoginCmd(f *cmdutil.Factory) *LoginCmd {
+	return &LoginCmd{
+		Io:            f.IOStreams,
+		F:             f,
+		AskToken:      askToken,
+		AskPassphrase: utils.AskPassword,
+		NewToken:      token.New,
+	}
+}
+
+func NewCobraCmd(login *LoginCmd, f *cmdutil.Factory) *cobra.Command {
+	var withToken, encrypt bool
+	cobraCmd := &cobra.Command{
+		Use:           msg.LoginUsage,
+		Short:         msg.LoginShortDescription,
+		Long:          msg.LoginLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion login
+		$ azion login --with-token < token.txt
+		$ azion login --profile stage
+		$ azion login --encrypt
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return login.Run(withToken, encrypt)
+		},
+	}
+
+	cobraCmd.Flags().BoolVar(&withToken, "with-token", false, msg.LoginFlagWithToken)
+	cobraCmd.Flags().BoolVar(&encrypt, "encrypt", false, msg.LoginFlagEncrypt)
+	cobraCmd.Flags().BoolP("help", "h", false, msg.LoginFlagHelp)
+	return cobraCmd
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	return NewCobraCmd(NewLoginCmd(f), f)
+}
+
+func (cmd *LoginCmd) Run(withToken, encrypt bool) error {
+	logger.Debug("Running login command")
+
+	tok, err := cmd.readToken(withToken)
+	if err != nil {
+		return err
+	}
+
+	passphrase := ""
+	if encrypt {
+		passphrase, err = cmd.readPassphrase()
+		if err != nil {
+			return err
+		}
+	}
+
+	t, err := cmd.NewToken(&token.Config{
+		Client:     cmd.F.HttpClient,
+		Out:        cmd.Io.Out,
+		Profile:    cmd.F.Profile,
+		Endpoint:   cmd.F.Config.GetString("auth_url"),
+		Passphrase: passphrase,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", utils.ErrorTokenManager, err)
+	}
+
+	valid, err := t.Validate(&tok)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return utils.ErrorInvalidToken
+	}
+
+	if err := t.Save(); err != nil {
+		return err
+	}
+
+	logger.FInfo(cmd.Io.Out, msg.LoginSuccessful)
+	return nil
+}
+
+// readToken reads the token from the standard input when it is piped or --with-token is sent,
+// otherwise it asks for the token without echoing it
+func (cmd *LoginCmd) readToken(withToken bool) (string, error) {
+	var tok string
+	if withToken || !cmd.Io.IsStdinTTY() {
+		data, err := io.ReadAll(cmd.Io.In)
+		if err != nil {
+			logger.Debug("Error while reading token from stdin", zap.Error(err))
+			return "", msg.ErrorReadingToken
+		}
+		tok = string(data)
+	} else {
+		if !cmd.F.CanPrompt() {
+			return "", fmt.Errorf(utils.ErrorMissingFlag.Error(), "with-token")
+		}
+
+		var err error
+		tok, err = cmd.AskToken(msg.LoginAskToken)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	tok = strings.TrimSpace(tok)
+	if tok == "" {
+		return "", msg.ErrorEmptyToken
+	}
+	return tok, nil
+}
+
+// readPassphrase returns the passphrase of AZIONCLI_CREDENTIALS_PASSPHRASE or asks for a new one
+func (cmd *LoginCmd) readPassphrase() (string, error) {
+	if passphrase := token.EnvPassphrase(); passphrase != "" {
+		return passphrase, nil
+	}
+
+	if !cmd.F.CanPrompt() {
+		return "", msg.ErrorPassphraseRequired
+	}
+
+	passphrase, err := cmd.AskPassphrase(msg.LoginAskPassphrase)
+	if err != nil {
+		return "", err
+	}
+
+	confirm, err := cmd.AskPassphrase(msg.LoginAskPassphraseConfirm)
+	if err != nil {
+		return "", err
+	}
+	if passphrase != confirm {
+		return "", msg.ErrorPassphraseMismatch
+	}
+	return passphrase, nil
+}
+
+func askToken(message string) (string, error) {
+	answer := ""
+	prompt := &survey.Password{
+		Message: message,
+	}
+	err := survey.AskOne(prompt, &answer)
+	return answer, err
+}
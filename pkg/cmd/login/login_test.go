@@ -0,0 +1,117 @@
+package login
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogin(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	dir := t.TempDir()
+	config.SetPath(dir)
+	defer config.SetPath(".azion")
+
+	t.Run("token from stdin", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "api/user/me"),
+			httpmock.StatusStringResponse(http.StatusOK, "{}"),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		f.Config.SetDefault("auth_url", "https://sso.azion.com/api/user/me")
+		f.IOStreams.In = io.NopCloser(strings.NewReader("azionb43a9554776\n"))
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--with-token"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "Logged in successfully")
+
+		data, err := os.ReadFile(filepath.Join(dir, "credentials"))
+		require.NoError(t, err)
+		require.Equal(t, "azionb43a9554776", string(data))
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "api/user/me"),
+			httpmock.StatusStringResponse(http.StatusUnauthorized, "{}"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.Config.SetDefault("auth_url", "https://sso.azion.com/api/user/me")
+		f.IOStreams.In = io.NopCloser(strings.NewReader("invalid"))
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--with-token"})
+		_, err := cmd.ExecuteC()
+		require.Error(t, err)
+	})
+
+	t.Run("hidden prompt", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "api/user/me"),
+			httpmock.StatusStringResponse(http.StatusOK, "{}"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.Config.SetDefault("auth_url", "https://sso.azion.com/api/user/me")
+		f.IOStreams.SetStdinTTY(true)
+
+		login := NewLoginCmd(f)
+		login.AskToken = func(msg string) (string, error) {
+			return "azionprompted", nil
+		}
+		require.NoError(t, login.Run(false, false))
+	})
+
+	t.Run("no input and no token", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		f.IOStreams.SetStdinTTY(true)
+		f.NoInput = true
+
+		err := NewLoginCmd(f).Run(false, false)
+		require.ErrorContains(t, err, "--with-token")
+	})
+
+	t.Run("encrypted token", func(t *testing.T) {
+		t.Setenv(token.PassphraseEnv, "s3cret")
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "api/user/me"),
+			httpmock.StatusStringResponse(http.StatusOK, "{}"),
+		)
+
+		f, _, _ := testutils.NewFactory(mock)
+		f.Config.SetDefault("auth_url", "https://sso.azion.com/api/user/me")
+		f.IOStreams.In = io.NopCloser(strings.NewReader("azionb43a9554776"))
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{"--with-token", "--encrypt"})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(dir, "credentials"))
+		require.NoError(t, err)
+		require.True(t, token.IsEncrypted(string(data)))
+
+		tok, err := token.ReadFromDisk()
+		require.NoError(t, err)
+		require.Equal(t, "azionb43a9554776", tok)
+	})
+}
@@ -0,0 +1,48 @@
+package logout
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/logout"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.LogoutUsage,
+		Short:         msg.LogoutShortDescription,
+		Long:          msg.LogoutLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion logout
+		$ azion logout --profile stage
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger.Debug("Running logout command")
+
+			location, err := token.Remove(f.Profile)
+			if err != nil {
+				logger.Debug("Error while removing token", zap.Error(err))
+				return utils.WrapError(msg.ErrorRemovingToken, err)
+			}
+
+			if location == "" {
+				logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.LogoutNotLoggedIn, f.Profile))
+				return nil
+			}
+
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.LogoutSuccessful, location))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.LogoutFlagHelp)
+	return cmd
+}
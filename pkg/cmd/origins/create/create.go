@@ -42,9 +42,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-        $ azion origins create --application-id 1673635839 --name "ffcafe222sdsdffdf" --addresses "httpbin.org" --host-header "asdf.safe" --origin-type "single_origin" --origin-protocol-policy "http" --origin-path "/requests" --hmac-authentication "false"
-        $ azion origins create --application-id 1673635839 --name "drink coffe" --addresses "asdfg.asd" --host-header "host"
-        $ azion origins create --application-id 1673635839 --in "create.json"
+        $ azion create origin --application-id 1673635839 --name "ffcafe222sdsdffdf" --addresses "httpbin.org" --host-header "asdf.safe" --origin-type "single_origin" --origin-protocol-policy "http" --origin-path "/requests" --hmac-authentication "false"
+        $ azion create origin --application-id 1673635839 --name "drink coffe" --addresses "asdfg.asd" --host-header "host"
+        $ azion create origin --application-id 1673635839 --in "create.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			request := api.CreateOriginsRequest{}
@@ -104,7 +104,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.CreateOrigins(context.Background(), fields.ApplicationID, &request)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreateOrigin.Error(), err)
+				return utils.WrapError(msg.ErrorCreateOrigin, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.OriginsCreateOutputSuccess, response.GetOriginId())
 			return nil
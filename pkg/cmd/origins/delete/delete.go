@@ -8,6 +8,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/origins"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -21,8 +22,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		  $ azion origins delete --application-id 1673635839 --origin-key 03a6e7bf-8e26-49c7-a66e-ab8eaa425086
-		  $ azion origins delete -a 1673635839 -o 03a6e7bf-8e26-49c7-a66e-ab8eaa425086
+		  $ azion delete origin --application-id 1673635839 --origin-key 03a6e7bf-8e26-49c7-a66e-ab8eaa425086
+		  $ azion delete origin -a 1673635839 -o 03a6e7bf-8e26-49c7-a66e-ab8eaa425086
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("origin-key") {
@@ -30,7 +31,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 			if err := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token")).
 				DeleteOrigins(context.Background(), applicationID, originKey); err != nil {
-				return fmt.Errorf(msg.ErrorFailToDelete.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDelete, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.OriginsDeleteOutputSuccess, originKey)
 			return nil
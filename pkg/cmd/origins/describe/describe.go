@@ -2,19 +2,14 @@ package describe
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"path/filepath"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	"github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/origins"
 
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
 	"github.com/aziontech/azion-cli/utils"
 	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
 	"github.com/spf13/cobra"
@@ -34,9 +29,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-      $ azion origins describe --application-id 1673635839 --origin-id 31223
-      $ azion origins describe --application-id 1673635839 --origin-id 31223--format json
-      $ azion origins describe --application-id 1673635839 --origin-id 31223--out "./tmp/test.json" --format json
+      $ azion describe origin --application-id 1673635839 --origin-id 31223
+      $ azion describe origin --application-id 1673635839 --origin-id 31223--format json
+      $ azion describe origin --application-id 1673635839 --origin-id 31223--out "./tmp/test.json" --format json
     `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") || !cmd.Flags().Changed("origin-id") {
@@ -47,67 +42,42 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			ctx := context.Background()
 			origin, err := client.GetOrigin(ctx, applicationID, originID)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorGetOrigin.Error(), err)
+				return utils.WrapError(msg.ErrorGetOrigin, err)
 			}
 
-			out := f.IOStreams.Out
-			formattedFuction, err := format(cmd, origin)
+			p, err := cmdutil.NewPrinter(f, &opts.OutputOptions)
 			if err != nil {
-				return utils.ErrorFormatOut
-			}
-
-			if cmd.Flags().Changed("out") {
-				err := cmdutil.WriteDetailsToFile(formattedFuction, opts.OutPath, out)
-				if err != nil {
-					return fmt.Errorf("%s: %w", utils.ErrorWriteFile, err)
-				}
-				fmt.Fprintf(out, msg.OriginsFileWritten, filepath.Clean(opts.OutPath))
-			} else {
-				_, err := out.Write(formattedFuction[:])
-				if err != nil {
-					return err
-				}
+				return err
 			}
 
-			return nil
+			return p.Describe(origin, fields(origin))
 		},
 	}
 
 	cmd.Flags().Int64VarP(&applicationID, "application-id", "a", 0, msg.OriginsDescribeFlagApplicationID)
 	cmd.Flags().Int64VarP(&originID, "origin-id", "o", 0, msg.OriginsDescribeFlagOriginID)
-	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.OriginsDescribeFlagOut)
-	cmd.Flags().StringVar(&opts.Format, "format", "", msg.OriginsDescribeFlagFormat)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	cmd.Flags().BoolP("help", "h", false, msg.OriginsDescribeHelpFlag)
 
 	return cmd
 }
 
-func format(cmd *cobra.Command, origin sdk.OriginsResultResponse) ([]byte, error) {
-	format, err := cmd.Flags().GetString("format")
-	if err != nil {
-		return nil, err
+func fields(origin sdk.OriginsResultResponse) []printer.Field {
+	return []printer.Field{
+		{Label: "Origin ID", Value: origin.OriginId},
+		{Label: "Name", Value: origin.Name},
+		{Label: "Origin Type", Value: origin.OriginType},
+		{Label: "Addresses", Value: origin.Addresses},
+		{Label: "Origin Protocol Policy", Value: origin.OriginProtocolPolicy},
+		{Label: "Is Origin Redirection Enable", Value: origin.IsOriginRedirectionEnabled},
+		{Label: "Host Header", Value: origin.HostHeader},
+		{Label: "Method", Value: origin.Method},
+		{Label: "Origin Path", Value: origin.OriginPath},
+		{Label: "Connection Timeout", Value: origin.ConnectionTimeout},
+		{Label: "Timeout Between Bytes", Value: origin.TimeoutBetweenBytes},
+		{Label: "Hmac Authentication", Value: origin.HmacAuthentication},
+		{Label: "Hmac Region Name", Value: origin.HmacRegionName},
+		{Label: "Hmac Secret Key", Value: origin.HmacSecretKey},
+		{Label: "Hmac Access Key", Value: origin.HmacAccessKey},
 	}
-
-	if format == "json" || cmd.Flags().Changed("out") {
-		return json.MarshalIndent(origin, "", " ")
-	}
-
-	tbl := tablecli.New("", "")
-	tbl.WithFirstColumnFormatter(color.New(color.FgGreen).SprintfFunc())
-	tbl.AddRow("Origin ID: ", origin.OriginId)
-	tbl.AddRow("Name: ", origin.Name)
-	tbl.AddRow("Origin Type: ", origin.OriginType)
-	tbl.AddRow("Addresses: ", origin.Addresses)
-	tbl.AddRow("Origin Protocol Policy: ", origin.OriginProtocolPolicy)
-	tbl.AddRow("Is Origin Redirection Enable: ", origin.IsOriginRedirectionEnabled)
-	tbl.AddRow("Host Header: ", origin.HostHeader)
-	tbl.AddRow("Method: ", origin.Method)
-	tbl.AddRow("Origin Path: ", origin.OriginPath)
-	tbl.AddRow("Connection Timeout: ", origin.ConnectionTimeout)
-	tbl.AddRow("Timeout Between Bytes: ", origin.TimeoutBetweenBytes)
-	tbl.AddRow("Hmac Authentication: ", origin.HmacAuthentication)
-	tbl.AddRow("Hmac Region Name: ", origin.HmacRegionName)
-	tbl.AddRow("Hmac Secret Key: ", origin.HmacSecretKey)
-	tbl.AddRow("Hmac Access Key: ", origin.HmacAccessKey)
-	return tbl.GetByteFormat(), nil
 }
@@ -2,17 +2,13 @@ package list
 
 import (
 	"context"
-	"fmt"
-	"strings"
-
-	"github.com/fatih/color"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	msg "github.com/aziontech/azion-cli/messages/origins"
 	api "github.com/aziontech/azion-cli/pkg/api/edge_applications"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -26,9 +22,10 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Long:          msg.OriginsListLongDescription,
 		SilenceUsage:  true,
 		SilenceErrors: true, Example: heredoc.Doc(`
-        $ azion origins list -a 16736354321
-        $ azion origins list --application-id 16736354321
-        $ azion origins list --application-id 16736354321 --details
+        $ azion list origin -a 16736354321
+        $ azion list origin --application-id 16736354321
+        $ azion list origin --application-id 16736354321 --details
+        $ azion list origin --application-id 16736354321 --format json
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if !cmd.Flags().Changed("application-id") {
@@ -36,13 +33,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			}
 
 			if err := PrintTable(cmd, f, opts); err != nil {
-				return fmt.Errorf(msg.ErrorGetOrigins.Error(), err)
+				return utils.WrapError(msg.ErrorGetOrigins, err)
 			}
 			return nil
 		},
 	}
 
 	cmdutil.AddAzionApiFlags(cmd, opts)
+	cmdutil.AddOutputFlags(cmd, &opts.OutputOptions)
 	flags := cmd.Flags()
 	flags.Int64VarP(&edgeApplicationID, "application-id", "a", 0, msg.OriginsListFlagEdgeApplicationID)
 	flags.BoolP("help", "h", false, msg.OriginsListHelpFlag)
@@ -50,38 +48,27 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 }
 
 func PrintTable(cmd *cobra.Command, f *cmdutil.Factory, opts *contracts.ListOptions) error {
+	headers := []string{"ID", "NAME"}
+	if cmd.Flags().Changed("details") {
+		headers = []string{"ID", "NAME", "ORIGIN TYPE", "ORIGIN PATH", "ADDRESSES", "CONNECTION TIMEOUT"}
+	}
+
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+	if err != nil {
+		return err
+	}
+
 	client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 	ctx := context.Background()
 
 	response, err := client.ListOrigins(ctx, opts, edgeApplicationID)
 	if err != nil {
-		return fmt.Errorf(msg.ErrorGetOrigins.Error(), err)
-	}
-
-	tbl := table.New("ID", "NAME")
-	tbl.WithWriter(f.IOStreams.Out)
-	if cmd.Flags().Changed("details") {
-		tbl = table.New("ID", "NAME", "ORIGIN TYPE", "ORIGIN PATH", "ADDRESSES", "CONNECTION TIMEOUT")
+		return utils.WrapError(msg.ErrorGetOrigins, err)
 	}
 
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
-	if cmd.Flags().Changed("details") {
-		for _, v := range response.Results {
-			tbl.AddRow(v.OriginId, v.Name, v.OriginType, v.OriginPath, v.Addresses, v.ConnectionTimeout)
-		}
-	} else {
-		for _, v := range response.Results {
-			tbl.AddRow(v.OriginId, v.Name)
-		}
+	for _, v := range response.Results {
+		p.AddRow(v, v.OriginId, v.Name, v.OriginType, v.OriginPath, v.Addresses, v.ConnectionTimeout)
 	}
 
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	tbl.PrintHeader(format)
-	for _, row := range tbl.GetRows() {
-		tbl.PrintRow(format, row)
-	}
-	return nil
+	return p.Close()
 }
@@ -0,0 +1,90 @@
+package add
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var validName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	var (
+		profile  config.Profile
+		defaults []string
+		use      bool
+	)
+
+	cmd := &cobra.Command{
+		Use:           msg.ProfileAddUsage,
+		Short:         msg.ProfileAddShortDescription,
+		Long:          msg.ProfileAddLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion profile add stage --token azionb43a9554776zeg05b11cb1declkbabcc9la --api-url https://stage-api.azion.net --storage-url https://stage-storage-api.azion.net
+		$ azion profile add customer --token azionb43a9554776zeg05b11cb1declkbabcc9la --default package_manager=pnpm --use
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if !validName.MatchString(name) {
+				return fmt.Errorf(msg.ErrorInvalidName.Error(), name)
+			}
+
+			profiles, err := config.ReadProfiles()
+			if err != nil {
+				logger.Debug("Error while reading profiles", zap.Error(err))
+				return utils.ErrorReadingProfiles
+			}
+
+			if _, ok := profiles.Profiles[name]; ok || name == config.DefaultProfile {
+				return fmt.Errorf(msg.ErrorProfileExists.Error(), name)
+			}
+
+			for _, def := range defaults {
+				kv := strings.SplitN(def, "=", 2)
+				if len(kv) != 2 || kv[0] == "" {
+					return fmt.Errorf(msg.ErrorInvalidDefault.Error(), def)
+				}
+				if profile.Defaults == nil {
+					profile.Defaults = map[string]string{}
+				}
+				profile.Defaults[kv[0]] = kv[1]
+			}
+
+			profiles.Profiles[name] = profile
+			if use {
+				profiles.Current = name
+			}
+
+			if err := config.WriteProfiles(profiles); err != nil {
+				logger.Debug("Error while writing profiles", zap.Error(err))
+				return msg.ErrorWritingProfiles
+			}
+
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ProfileAddOutputSuccess, name))
+			if use {
+				logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ProfileUseOutputSuccess, name))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&profile.Token, "token", "", msg.ProfileAddFlagToken)
+	cmd.Flags().StringVar(&profile.ApiURL, "api-url", "", msg.ProfileAddFlagApiURL)
+	cmd.Flags().StringVar(&profile.StorageURL, "storage-url", "", msg.ProfileAddFlagStorageURL)
+	cmd.Flags().StringArrayVar(&defaults, "default", nil, msg.ProfileAddFlagDefault)
+	cmd.Flags().BoolVar(&use, "use", false, msg.ProfileAddFlagUse)
+	cmd.Flags().BoolP("help", "h", false, msg.ProfileAddHelpFlag)
+	return cmd
+}
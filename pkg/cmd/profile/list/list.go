@@ -0,0 +1,81 @@
+package list
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+// listItem is the JSON and YAML view of a profile. It tells whether a token is set without showing it
+type listItem struct {
+	Name       string `json:"name"`
+	Current    bool   `json:"current"`
+	TokenSet   bool   `json:"token_set"`
+	ApiURL     string `json:"api_url,omitempty"`
+	StorageURL string `json:"storage_url,omitempty"`
+}
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	output := &contracts.OutputOptions{}
+	cmd := &cobra.Command{
+		Use:           msg.ProfileListUsage,
+		Short:         msg.ProfileListShortDescription,
+		Long:          msg.ProfileListLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion profile list
+		$ azion profile list --format json
+		`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			profiles, err := config.ReadProfiles()
+			if err != nil {
+				logger.Debug("Error while reading profiles", zap.Error(err))
+				return utils.ErrorReadingProfiles
+			}
+
+			p, err := cmdutil.NewPrinter(f, output, "", "NAME", "TOKEN", "API URL", "STORAGE URL")
+			if err != nil {
+				return err
+			}
+
+			for _, name := range profiles.Names() {
+				profile := profiles.Profiles[name]
+
+				current := ""
+				if name == f.Profile {
+					current = "*"
+				}
+
+				tokenState := msg.ProfileTokenNotSet
+				if profile.Token != "" {
+					tokenState = msg.ProfileTokenSet
+				}
+
+				item := listItem{
+					Name:       name,
+					Current:    name == f.Profile,
+					TokenSet:   profile.Token != "",
+					ApiURL:     profile.ApiURL,
+					StorageURL: profile.StorageURL,
+				}
+				p.AddRow(item, current, name, tokenState, profile.ApiURL, profile.StorageURL)
+			}
+
+			if err := p.Flush(); err != nil {
+				return err
+			}
+			return p.Close()
+		},
+	}
+
+	cmdutil.AddOutputFlags(cmd, output)
+	cmd.Flags().BoolP("help", "h", false, msg.ProfileListHelpFlag)
+	return cmd
+}
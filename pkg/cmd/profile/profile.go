@@ -0,0 +1,33 @@
+package profile
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/add"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/list"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/remove"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile/use"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	profileCmd := &cobra.Command{
+		Use:   msg.ProfileUsage,
+		Short: msg.ProfileShortDescription,
+		Long:  msg.ProfileLongDescription,
+		Example: heredoc.Doc(`
+		$ azion profile --help
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	profileCmd.AddCommand(list.NewCmd(f))
+	profileCmd.AddCommand(use.NewCmd(f))
+	profileCmd.AddCommand(add.NewCmd(f))
+	profileCmd.AddCommand(remove.NewCmd(f))
+	profileCmd.Flags().BoolP("help", "h", false, msg.ProfileFlagHelp)
+	return profileCmd
+}
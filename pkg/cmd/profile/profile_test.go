@@ -0,0 +1,81 @@
+package profile
+
+import (
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestProfile(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	config.SetPath(t.TempDir())
+	defer config.SetPath(".azion")
+
+	run := func(args ...string) (string, error) {
+		f, stdout, _ := testutils.NewFactory(nil)
+		f.Profile = config.DefaultProfile
+		cmd := NewCmd(f)
+		cmd.SetArgs(args)
+		_, err := cmd.ExecuteC()
+		return stdout.String(), err
+	}
+
+	t.Run("add profile", func(t *testing.T) {
+		out, err := run("add", "stage", "--token", "123", "--api-url", "https://stage-api.azion.net", "--default", "package_manager=pnpm", "--use")
+		require.NoError(t, err)
+		require.Contains(t, out, "Added profile stage")
+
+		profiles, err := config.ReadProfiles()
+		require.NoError(t, err)
+		require.Equal(t, "stage", profiles.CurrentName())
+		require.Equal(t, "123", profiles.Profiles["stage"].Token)
+		require.Equal(t, "pnpm", profiles.Profiles["stage"].Defaults["package_manager"])
+	})
+
+	t.Run("add existing profile", func(t *testing.T) {
+		_, err := run("add", "stage")
+		require.ErrorContains(t, err, "already exists")
+	})
+
+	t.Run("invalid default", func(t *testing.T) {
+		_, err := run("add", "other", "--default", "package_manager")
+		require.ErrorContains(t, err, "invalid")
+	})
+
+	t.Run("list profiles", func(t *testing.T) {
+		out, err := run("list")
+		require.NoError(t, err)
+		require.Contains(t, out, "default")
+		require.Contains(t, out, "https://stage-api.azion.net")
+	})
+
+	t.Run("use unknown profile", func(t *testing.T) {
+		_, err := run("use", "prod")
+		require.ErrorContains(t, err, "doesn't exist")
+	})
+
+	t.Run("use default profile", func(t *testing.T) {
+		_, err := run("use", "default")
+		require.NoError(t, err)
+
+		profiles, err := config.ReadProfiles()
+		require.NoError(t, err)
+		require.Equal(t, config.DefaultProfile, profiles.CurrentName())
+	})
+
+	t.Run("remove profile", func(t *testing.T) {
+		_, err := run("remove", "stage")
+		require.NoError(t, err)
+
+		profiles, err := config.ReadProfiles()
+		require.NoError(t, err)
+		require.False(t, profiles.Exists("stage"))
+
+		_, err = run("remove", "default")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,60 @@
+package remove
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.ProfileRemoveUsage,
+		Short:         msg.ProfileRemoveShortDescription,
+		Long:          msg.ProfileRemoveLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion profile remove stage
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			if name == config.DefaultProfile {
+				return msg.ErrorRemoveDefault
+			}
+
+			profiles, err := config.ReadProfiles()
+			if err != nil {
+				logger.Debug("Error while reading profiles", zap.Error(err))
+				return utils.ErrorReadingProfiles
+			}
+
+			if _, ok := profiles.Profiles[name]; !ok {
+				return fmt.Errorf(utils.ErrorProfileNotFound.Error(), name)
+			}
+
+			delete(profiles.Profiles, name)
+			if profiles.Current == name {
+				profiles.Current = ""
+			}
+
+			if err := config.WriteProfiles(profiles); err != nil {
+				logger.Debug("Error while writing profiles", zap.Error(err))
+				return msg.ErrorWritingProfiles
+			}
+
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ProfileRemoveOutputSuccess, name))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.ProfileRemoveHelpFlag)
+	return cmd
+}
@@ -0,0 +1,58 @@
+package use
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/profile"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.ProfileUseUsage,
+		Short:         msg.ProfileUseShortDescription,
+		Long:          msg.ProfileUseLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Args:          cobra.ExactArgs(1),
+		Example: heredoc.Doc(`
+		$ azion profile use stage
+		$ azion profile use default
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+
+			profiles, err := config.ReadProfiles()
+			if err != nil {
+				logger.Debug("Error while reading profiles", zap.Error(err))
+				return utils.ErrorReadingProfiles
+			}
+
+			if !profiles.Exists(name) {
+				return fmt.Errorf(utils.ErrorProfileNotFound.Error(), name)
+			}
+
+			profiles.Current = name
+			if name == config.DefaultProfile {
+				profiles.Current = ""
+			}
+
+			if err := config.WriteProfiles(profiles); err != nil {
+				logger.Debug("Error while writing profiles", zap.Error(err))
+				return msg.ErrorWritingProfiles
+			}
+
+			logger.FInfo(f.IOStreams.Out, fmt.Sprintf(msg.ProfileUseOutputSuccess, name))
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.ProfileUseHelpFlag)
+	return cmd
+}
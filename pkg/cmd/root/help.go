@@ -156,6 +156,13 @@ func rootHelpFunc(f *cmdutil.Factory, command *cobra.Command, args []string) {
 		})
 	}
 
+	if isRootCmd(command) {
+		helpEntries = append(helpEntries, helpEntry{
+			Title: color.New(styleTitle).Sprint("EXIT CODES"),
+			Body:  color.New(styleBody).Sprint(msg.ExitCodes),
+		})
+	}
+
 	helpEntries = append(helpEntries, helpEntry{
 		Title: color.New(styleTitle).Sprint("LEARN MORE"),
 		Body:  color.New(styleBody).Sprint("\nUse 'azion <command> <subcommand> --help' for more information about a command"),
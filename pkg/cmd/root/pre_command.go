@@ -1,18 +1,39 @@
 package root
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	msg "github.com/aziontech/azion-cli/messages/root"
+	"github.com/aziontech/azion-cli/pkg/api/client"
+	"github.com/aziontech/azion-cli/pkg/cmd/version"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
 	"github.com/aziontech/azion-cli/utils"
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.uber.org/zap"
 )
 
 type PreCmd struct {
-	token  string
-	config string
+	token     string
+	config    string
+	profile   string
+	debugHTTP string
+	noColor   bool
+	logFile   string
+	args      []string
 }
 
 // doPreCommandCheck carry out all pre-cmd checks needed
@@ -22,6 +43,26 @@ func doPreCommandCheck(cmd *cobra.Command, f *cmdutil.Factory, pre PreCmd) error
 		return err
 	}
 
+	if err := loadSettings(f); err != nil {
+		return err
+	}
+
+	if err := applyProfile(cmd, f, pre.profile); err != nil {
+		return err
+	}
+
+	if err := useSettings(f, pre.noColor); err != nil {
+		return err
+	}
+
+	if err := openLogFile(cmd, f, pre); err != nil {
+		return err
+	}
+
+	if err := traceHTTP(cmd, f, pre.debugHTTP); err != nil {
+		return err
+	}
+
 	if err := checkTokenSent(cmd, f, pre.token); err != nil {
 		return err
 	}
@@ -39,13 +80,253 @@ func setConfigPath(cmd *cobra.Command, cfg string) error {
 	return nil
 }
 
+// loadSettings reads the config.yaml file of the config directory, which may be changed by --config.
+// Environment variables and profiles take precedence over it
+func loadSettings(f *cmdutil.Factory) error {
+	if err := config.MigratePreferences(); err != nil {
+		logger.Debug("Error while moving preferences to settings", zap.Error(err))
+	}
+	if err := config.MigrateTemplateSources(); err != nil {
+		logger.Debug("Error while moving template sources to settings", zap.Error(err))
+	}
+
+	settings, err := config.ReadSettings()
+	if err != nil {
+		logger.Debug("Error while reading settings", zap.Error(err))
+		return utils.ErrorReadingSettings
+	}
+
+	for key, value := range settings {
+		f.Config.SetDefault(key, value)
+	}
+	return nil
+}
+
+// useSettings applies the settings that configure the factory itself. --no-color takes precedence
+// over the color setting, which takes precedence over NO_COLOR and CLICOLOR_FORCE
+func useSettings(f *cmdutil.Factory, noColor bool) error {
+	proxy, caBundle := f.Config.GetString("proxy"), f.Config.GetString("ca_bundle")
+	if f.HttpClient.Transport == nil && (proxy != "" || caBundle != "") {
+		transport, err := client.NewTransport(proxy, caBundle)
+		if err != nil {
+			logger.Debug("Error while configuring the HTTP transport", zap.Error(err))
+			return fmt.Errorf(utils.ErrorHTTPTransport.Error(), err)
+		}
+		f.HttpClient.Transport = transport
+	}
+
+	if timeout, err := time.ParseDuration(f.Config.GetString("http_timeout")); err == nil && timeout > 0 {
+		f.HttpClient.Timeout = timeout
+	}
+
+	switch {
+	case noColor:
+		f.IOStreams.SetColorEnabled(false)
+	case f.Config.GetString("color") == "always":
+		f.IOStreams.SetColorEnabled(true)
+	case f.Config.GetString("color") == "never":
+		f.IOStreams.SetColorEnabled(false)
+	}
+	color.NoColor = !f.IOStreams.ColorEnabled()
+	return nil
+}
+
+// openLogFile makes the logger also write to a file when --log-file or the log_file setting is set.
+// Its entries carry the command line, the CLI version and an ID of the invocation
+func openLogFile(cmd *cobra.Command, f *cmdutil.Factory, pre PreCmd) error {
+	target := pre.logFile
+	if !cmd.Flags().Changed("log-file") {
+		target = f.Config.GetString("log_file")
+	}
+
+	switch strings.ToLower(target) {
+	case "", "0", "false":
+		return nil
+	case "1", "true":
+		path, err := config.LogFilePath()
+		if err != nil {
+			logger.Debug("Error while getting the log file path", zap.Error(err))
+			return fmt.Errorf(utils.ErrorOpeningLogFile.Error(), target, err)
+		}
+		target = path
+	}
+
+	err := logger.AddFile(target,
+		zap.String("command", commandLine(cmd, pre.args)),
+		zap.String("version", version.BinVersion),
+		zap.String("invocation_id", invocationID()),
+	)
+	if err != nil {
+		return fmt.Errorf(utils.ErrorOpeningLogFile.Error(), target, err)
+	}
+	logger.Debug("Command started", zap.String("profile", f.Profile))
+	return nil
+}
+
+// secretFlags matches the flags whose values are left out of the logged command line
+var secretFlags = regexp.MustCompile(`(?i)token|password|passphrase|secret|key`)
+
+// commandLine returns the command with the flags that were sent, redacting secrets, and args
+func commandLine(cmd *cobra.Command, args []string) string {
+	parts := []string{cmd.CommandPath()}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		value := flag.Value.String()
+		if secretFlags.MatchString(flag.Name) {
+			value = "[REDACTED]"
+		}
+		parts = append(parts, fmt.Sprintf("--%s=%s", flag.Name, value))
+	})
+	return strings.Join(append(parts, args...), " ")
+}
+
+// invocationID returns a random ID that groups the log entries of one run of the CLI
+func invocationID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 36)
+	}
+	return hex.EncodeToString(b)
+}
+
+// traceFile is the file of the HTTP trace, closed by closeTrace once the command finishes
+var traceFile *os.File
+
+// traceHTTP logs every request and response of the command when --debug-http or AZIONCLI_HTTP_TRACE
+// is set. The trace goes to stderr or, when a path is given, to that file
+func traceHTTP(cmd *cobra.Command, f *cmdutil.Factory, target string) error {
+	if !cmd.Flags().Changed("debug-http") {
+		target = f.Config.GetString("http_trace")
+	}
+
+	switch strings.ToLower(target) {
+	case "", "0", "false":
+		return nil
+	case "1", "true", "stderr":
+		f.HttpClient.Transport = &client.TraceTransport{Base: f.HttpClient.Transport, Out: f.IOStreams.Err}
+		return nil
+	}
+
+	file, err := os.OpenFile(target, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		logger.Debug("Error while opening the HTTP trace file", zap.Error(err))
+		return fmt.Errorf(utils.ErrorOpeningTraceFile.Error(), target)
+	}
+	_ = closeTrace()
+	traceFile = file
+	f.HttpClient.Transport = &client.TraceTransport{Base: f.HttpClient.Transport, Out: file}
+	return nil
+}
+
+// closeTrace closes the file of the HTTP trace opened by traceHTTP
+func closeTrace() error {
+	if traceFile == nil {
+		return nil
+	}
+	err := traceFile.Close()
+	traceFile = nil
+	return err
+}
+
+// applyProfile uses the token, endpoints and defaults of the profile chosen with --profile,
+// AZIONCLI_PROFILE or 'azion profile use'. Environment variables still take precedence over them
+func applyProfile(cmd *cobra.Command, f *cmdutil.Factory, name string) error {
+	profiles, err := config.ReadProfiles()
+	if err != nil {
+		logger.Debug("Error while reading profiles", zap.Error(err))
+		return utils.ErrorReadingProfiles
+	}
+
+	if !cmd.Flags().Changed("profile") {
+		name = f.Config.GetString("profile")
+	}
+	if name == "" {
+		name = profiles.CurrentName()
+	}
+
+	if !profiles.Exists(name) {
+		return fmt.Errorf(utils.ErrorProfileNotFound.Error(), name)
+	}
+	f.Profile = name
+	logger.Debug("Using profile " + name)
+
+	// the token stays encrypted in the settings until a request needs it
+	client.RevealToken = revealToken(f)
+
+	profile := profiles.Profiles[name]
+	if name == config.DefaultProfile && profile.Token == "" {
+		if token.WorldReadable() {
+			path, _ := token.FilePath()
+			logger.LogWarning(f.IOStreams.Err, fmt.Sprintf(msg.RootWarnWorldReadable, path))
+		}
+
+		// the credentials file is read again since --config may point to another directory
+		tok, err := token.ReadSaved()
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		profile.Token = tok
+	}
+
+	for key, value := range profile.Defaults {
+		f.Config.SetDefault(key, value)
+	}
+	if profile.Token != "" {
+		f.Config.SetDefault("token", profile.Token)
+	}
+	if profile.ApiURL != "" {
+		f.Config.SetDefault("api_url", profile.ApiURL)
+	}
+	if profile.StorageURL != "" {
+		f.Config.SetDefault("storage_url", profile.StorageURL)
+	}
+
+	return nil
+}
+
+// revealToken decrypts an encrypted token the first time a request needs it, with the passphrase
+// of AZIONCLI_CREDENTIALS_PASSPHRASE or of a prompt, so commands that don't call the Azion APIs
+// never ask for it
+func revealToken(f *cmdutil.Factory) func(string) (string, error) {
+	var mu sync.Mutex
+	revealed := map[string]string{}
+
+	return func(value string) (string, error) {
+		if !token.IsEncrypted(value) {
+			return value, nil
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if tok, ok := revealed[value]; ok {
+			return tok, nil
+		}
+
+		var ask func() (string, error)
+		if f.CanPrompt() {
+			ask = func() (string, error) {
+				return utils.AskPassword(msg.RootAskPassphrase)
+			}
+		}
+
+		tok, err := token.Reveal(value, ask)
+		if err != nil {
+			logger.Debug("Error while decrypting the saved token", zap.Error(err))
+			return "", utils.NewError(utils.CodeAuth, err)
+		}
+		revealed[value] = tok
+		return tok, nil
+	}
+}
+
 func checkTokenSent(cmd *cobra.Command, f *cmdutil.Factory, configureToken string) error {
 
 	// if global --token flag was sent, verify it and save it locally
 	if cmd.Flags().Changed("token") {
 		t, err := token.New(&token.Config{
-			Client: f.HttpClient,
-			Out:    f.IOStreams.Out,
+			Client:   f.HttpClient,
+			Out:      f.IOStreams.Out,
+			Profile:  f.Profile,
+			Endpoint: f.Config.GetString("auth_url"),
 		})
 		if err != nil {
 			return fmt.Errorf("%s: %w", utils.ErrorTokenManager, err)
@@ -67,6 +348,9 @@ func checkTokenSent(cmd *cobra.Command, f *cmdutil.Factory, configureToken strin
 		if err := t.Save(); err != nil {
 			return err
 		}
+
+		// the token sent is used by the current command too
+		f.Config.SetDefault("token", configureToken)
 	}
 
 	return nil
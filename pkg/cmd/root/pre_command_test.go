@@ -0,0 +1,311 @@
+package root
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	msgRoot "github.com/aziontech/azion-cli/messages/root"
+	"github.com/aziontech/azion-cli/pkg/api/client"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestApplyProfile(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	config.SetPath(t.TempDir())
+	defer config.SetPath(".azion")
+
+	require.NoError(t, config.WriteProfiles(&config.Profiles{
+		Current: "stage",
+		Profiles: map[string]config.Profile{
+			"stage": {Token: "stage-token", ApiURL: "https://stage-api.azion.net", Defaults: map[string]string{"package_manager": "pnpm"}},
+			"prod":  {Token: "prod-token"},
+		},
+	}))
+
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("profile", "", "")
+		return cmd
+	}
+
+	t.Run("current profile", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		require.NoError(t, applyProfile(newCmd(), f, ""))
+		require.Equal(t, "stage", f.Profile)
+		require.Equal(t, "stage-token", f.Config.GetString("token"))
+		require.Equal(t, "https://stage-api.azion.net", f.Config.GetString("api_url"))
+		require.Equal(t, "pnpm", f.Config.GetString("package_manager"))
+	})
+
+	t.Run("profile flag", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("profile", "prod"))
+		require.NoError(t, applyProfile(cmd, f, "prod"))
+		require.Equal(t, "prod", f.Profile)
+		require.Equal(t, "prod-token", f.Config.GetString("token"))
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("profile", "dev"))
+		require.Error(t, applyProfile(cmd, f, "dev"))
+	})
+}
+
+func TestLoadSettings(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	config.SetPath(t.TempDir())
+	defer config.SetPath(".azion")
+
+	require.NoError(t, config.WriteSettings(map[string]string{
+		"http_timeout": "45s",
+		"api_url":      "https://file-api.azion.net",
+	}))
+	require.NoError(t, config.WriteProfiles(&config.Profiles{
+		Current: "stage",
+		Profiles: map[string]config.Profile{
+			"stage": {ApiURL: "https://stage-api.azion.net"},
+		},
+	}))
+
+	f, _, _ := testutils.NewFactory(nil)
+	require.NoError(t, loadSettings(f))
+	cmd := &cobra.Command{}
+	cmd.Flags().String("profile", "", "")
+	require.NoError(t, applyProfile(cmd, f, ""))
+	require.NoError(t, useSettings(f, false))
+
+	require.Equal(t, 45*time.Second, f.HttpClient.Timeout)
+	// the profile takes precedence over the config file
+	require.Equal(t, "https://stage-api.azion.net", f.Config.GetString("api_url"))
+}
+
+func TestMigratePreferences(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	dir := t.TempDir()
+	config.SetPath(dir)
+	defer config.SetPath(".azion")
+
+	prefs := filepath.Join(dir, "preferences.json")
+	require.NoError(t, os.WriteFile(prefs, []byte(`{"package_manager": "yarn"}`), 0600))
+
+	f, _, _ := testutils.NewFactory(nil)
+	require.NoError(t, loadSettings(f))
+	require.Equal(t, "yarn", f.Config.GetString("package_manager"))
+	require.NoFileExists(t, prefs)
+
+	settings, err := config.ReadSettings()
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"package_manager": "yarn"}, settings)
+}
+
+func TestMigrateTemplateSources(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	dir := t.TempDir()
+	config.SetPath(dir)
+	defer config.SetPath(".azion")
+
+	require.NoError(t, config.WriteSettings(map[string]string{"templates.internal.ref": "v2"}))
+	templates := filepath.Join(dir, "templates.json")
+	require.NoError(t, os.WriteFile(templates, []byte(`[
+		{"name": "Internal", "repo": "https://github.com/acme/templates", "ref": "v1", "subdir": "starters"},
+		{"name": "local", "repo": "/opt/templates"}
+	]`), 0600))
+
+	f, _, _ := testutils.NewFactory(nil)
+	require.NoError(t, loadSettings(f))
+	require.NoFileExists(t, templates)
+
+	// the ref already saved in config.yaml is kept
+	sources, err := config.TemplateSources()
+	require.NoError(t, err)
+	require.Equal(t, []config.TemplateSource{
+		{Name: "internal", Repo: "https://github.com/acme/templates", Ref: "v2", Subdir: "starters"},
+		{Name: "local", Repo: "/opt/templates"},
+	}, sources)
+}
+
+func TestUseSettingsColor(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	defer func() { color.NoColor = true }()
+
+	tests := []struct {
+		name    string
+		setting string
+		noColor bool
+		enabled bool
+		want    bool
+	}{
+		{name: "auto keeps the terminal detection", setting: "auto", enabled: true, want: true},
+		{name: "always", setting: "always", want: true},
+		{name: "never", setting: "never", enabled: true, want: false},
+		{name: "--no-color takes precedence", setting: "always", noColor: true, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, _, _ := testutils.NewFactory(nil)
+			f.Config.SetDefault("color", tt.setting)
+			f.IOStreams.SetColorEnabled(tt.enabled)
+
+			require.NoError(t, useSettings(f, tt.noColor))
+			require.Equal(t, tt.want, f.IOStreams.ColorEnabled())
+			require.Equal(t, !tt.want, color.NoColor)
+		})
+	}
+}
+
+func TestTraceHTTP(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("debug-http", "", "")
+		return cmd
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		require.NoError(t, traceHTTP(newCmd(), f, ""))
+		require.Nil(t, f.HttpClient.Transport)
+	})
+
+	t.Run("flag traces to stderr", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("debug-http", "stderr"))
+		require.NoError(t, traceHTTP(cmd, f, "stderr"))
+
+		trace, ok := f.HttpClient.Transport.(*client.TraceTransport)
+		require.True(t, ok)
+		require.Equal(t, f.IOStreams.Err, trace.Out)
+	})
+
+	t.Run("environment traces to a file", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		path := filepath.Join(t.TempDir(), "trace.log")
+		f.Config.SetDefault("http_trace", path)
+		require.NoError(t, traceHTTP(newCmd(), f, ""))
+
+		_, ok := f.HttpClient.Transport.(*client.TraceTransport)
+		require.True(t, ok)
+		require.FileExists(t, path)
+
+		file := traceFile
+		require.NoError(t, closeTrace())
+		require.Nil(t, traceFile)
+		// the file is closed once the command finishes
+		require.ErrorIs(t, file.Close(), os.ErrClosed)
+	})
+}
+
+func TestOpenLogFile(t *testing.T) {
+	logger.New(zapcore.InfoLevel)
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{Use: "describe"}
+		cmd.Flags().String("log-file", "", "")
+		cmd.Flags().String("token", "", "")
+		cmd.Flags().String("application-id", "", "")
+		return cmd
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+		require.NoError(t, openLogFile(newCmd(), f, PreCmd{}))
+	})
+
+	t.Run("setting with a path", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "cli.log")
+		f, _, _ := testutils.NewFactory(nil)
+		f.Config.SetDefault("log_file", path)
+
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("token", "secret-token"))
+		require.NoError(t, cmd.Flags().Set("application-id", "12"))
+		require.NoError(t, openLogFile(cmd, f, PreCmd{args: []string{"extra"}}))
+		require.NoError(t, logger.CloseFile())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		require.Contains(t, string(data), `"command":"describe --application-id=12 --token=[REDACTED] extra"`)
+		require.Contains(t, string(data), `"invocation_id":`)
+		require.NotContains(t, string(data), "secret-token")
+	})
+
+	t.Run("flag without a path uses the config directory", func(t *testing.T) {
+		config.SetPath(t.TempDir())
+		defer config.SetPath(".azion")
+		f, _, _ := testutils.NewFactory(nil)
+
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("log-file", "true"))
+		require.NoError(t, openLogFile(cmd, f, PreCmd{logFile: "true"}))
+		require.NoError(t, logger.CloseFile())
+
+		path, err := config.LogFilePath()
+		require.NoError(t, err)
+		require.FileExists(t, path)
+	})
+}
+
+func TestEncryptedToken(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	dir := t.TempDir()
+	config.SetPath(dir)
+	defer config.SetPath(".azion")
+	defer func() {
+		client.RevealToken = func(tok string) (string, error) { return tok, nil }
+	}()
+	t.Setenv(token.PassphraseEnv, "")
+
+	sealed, err := token.Encrypt("azionb43a9554776", "s3cret")
+	require.NoError(t, err)
+	credentials := filepath.Join(dir, "credentials")
+
+	run := func(mock *httpmock.Registry, args ...string) error {
+		f, _, _ := testutils.NewFactory(mock)
+		f.Config.SetDefault("api_url", "https://api.azionapi.net")
+		cmd := NewCmd(f)
+		cmd.SetArgs(args)
+		_, err := cmd.ExecuteC()
+		return err
+	}
+
+	t.Run("local command without the passphrase", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(credentials, []byte(sealed), 0600))
+		require.NoError(t, run(nil, "logout"))
+		require.NoFileExists(t, credentials)
+	})
+
+	t.Run("API command without the passphrase", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(credentials, []byte(sealed), 0600))
+		err := run(&httpmock.Registry{}, "list", "edge-application")
+		require.ErrorContains(t, err, msgRoot.ErrorPassphraseRequired.Error())
+		require.Equal(t, utils.ExitAuth, utils.ExitCode(err))
+	})
+
+	t.Run("API command with the passphrase", func(t *testing.T) {
+		t.Setenv(token.PassphraseEnv, "s3cret")
+		require.NoError(t, os.WriteFile(credentials, []byte(sealed), 0600))
+
+		mock := &httpmock.Registry{}
+		mock.Register(func(req *http.Request) bool {
+			return req.URL.Path == "/edge_applications" && req.Header.Get("Authorization") == "token azionb43a9554776"
+		}, httpmock.JSONFromString(`{"count": 0, "total_pages": 1, "results": []}`))
+		require.NoError(t, run(mock, "list", "edge-application"))
+		mock.Verify(t)
+	})
+}
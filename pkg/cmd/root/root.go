@@ -1,30 +1,43 @@
 package root
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	msg "github.com/aziontech/azion-cli/messages/root"
+	"github.com/aziontech/azion-cli/pkg/cmd/apply"
 	buildCmd "github.com/aziontech/azion-cli/pkg/cmd/build"
 	"github.com/aziontech/azion-cli/pkg/cmd/completion"
 	"github.com/aziontech/azion-cli/pkg/cmd/create"
+	"github.com/aziontech/azion-cli/pkg/cmd/credentials"
 	"github.com/aziontech/azion-cli/pkg/cmd/delete"
 	"github.com/aziontech/azion-cli/pkg/cmd/describe"
+	"github.com/aziontech/azion-cli/pkg/cmd/diff"
+	"github.com/aziontech/azion-cli/pkg/cmd/export"
 	"github.com/aziontech/azion-cli/pkg/cmd/list"
+	"github.com/aziontech/azion-cli/pkg/cmd/profile"
 	"github.com/aziontech/azion-cli/pkg/cmd/update"
 
+	configcmd "github.com/aziontech/azion-cli/pkg/cmd/config"
 	deploycmd "github.com/aziontech/azion-cli/pkg/cmd/deploy"
 	devcmd "github.com/aziontech/azion-cli/pkg/cmd/dev"
 	initcmd "github.com/aziontech/azion-cli/pkg/cmd/init"
 	linkcmd "github.com/aziontech/azion-cli/pkg/cmd/link"
+	"github.com/aziontech/azion-cli/pkg/cmd/login"
+	"github.com/aziontech/azion-cli/pkg/cmd/logout"
 	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	"github.com/aziontech/azion-cli/pkg/cmd/whoami"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
 	"github.com/aziontech/azion-cli/pkg/constants"
 	"github.com/aziontech/azion-cli/pkg/iostreams"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -44,8 +57,12 @@ func NewRootCmd(f *cmdutil.Factory) *RootCmd {
 }
 
 var (
-	tokenFlag  string
-	configFlag string
+	tokenFlag   string
+	configFlag  string
+	profileFlag string
+	debugHTTP   string
+	noColor     bool
+	logFile     string
 )
 
 func NewCmd(f *cmdutil.Factory) *cobra.Command {
@@ -58,11 +75,16 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 		Long:    msg.RootDescription,
 		Short:   color.New(color.Bold).Sprint(fmt.Sprintf(msg.RootDescription, version.BinVersion)),
 		Version: version.BinVersion,
-		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			logger.LogLevel(f.Logger)
 			err := doPreCommandCheck(cmd, f, PreCmd{
-				config: configFlag,
-				token:  tokenFlag,
+				config:    configFlag,
+				token:     tokenFlag,
+				profile:   profileFlag,
+				debugHTTP: debugHTTP,
+				noColor:   noColor,
+				logFile:   logFile,
+				args:      args,
 			})
 			if err != nil {
 				return err
@@ -73,6 +95,9 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 		$ azion
 		$ azion -t azionb43a9554776zeg05b11cb1declkbabcc9la
 		$ azion --debug
+		$ azion --debug-http=trace.log list edge-application
+		$ azion --log-file list edge-application
+		$ azion --profile stage list edge-application
 		$ azion -h
 		`),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -89,15 +114,31 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 	cobraCmd.SetOut(f.IOStreams.Out)
 	cobraCmd.SetErr(f.IOStreams.Err)
 
+	cobraCmd.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return utils.NewError(utils.CodeValidation, err)
+	})
+
 	cobraCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		// help doesn't run the pre-command checks that apply --no-color
+		if noColor {
+			f.IOStreams.SetColorEnabled(false)
+			color.NoColor = true
+		}
 		rootHelpFunc(f, cmd, args)
 	})
 
 	// Global flags
 	cobraCmd.PersistentFlags().StringVarP(&tokenFlag, "token", "t", "", msg.RootTokenFlag)
 	cobraCmd.PersistentFlags().StringVarP(&configFlag, "config", "c", "", msg.RootConfigFlag)
+	cobraCmd.PersistentFlags().StringVar(&profileFlag, "profile", "", msg.RootProfileFlag)
 	cobraCmd.PersistentFlags().BoolVarP(&f.GlobalFlagAll, "yes", "y", false, msg.RootYesFlag)
+	cobraCmd.PersistentFlags().BoolVar(&f.NoInput, "no-input", false, msg.RootNoInputFlag)
 	cobraCmd.PersistentFlags().BoolVarP(&f.Debug, "debug", "d", false, msg.RootLogDebug)
+	cobraCmd.PersistentFlags().StringVar(&debugHTTP, "debug-http", "", msg.RootDebugHTTPFlag)
+	cobraCmd.PersistentFlags().Lookup("debug-http").NoOptDefVal = "stderr"
+	cobraCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, msg.RootNoColorFlag)
+	cobraCmd.PersistentFlags().StringVar(&logFile, "log-file", "", msg.RootLogFileFlag)
+	cobraCmd.PersistentFlags().Lookup("log-file").NoOptDefVal = "true"
 	cobraCmd.PersistentFlags().BoolVarP(&f.Silent, "silent", "s", false, msg.RootLogSilent)
 	cobraCmd.PersistentFlags().StringVarP(&f.LogLevel, "log-level", "l", "info", msg.RootLogDebug)
 
@@ -118,6 +159,15 @@ func NewCobraCmd(rootCmd *RootCmd, f *cmdutil.Factory) *cobra.Command {
 	cobraCmd.AddCommand(list.NewCmd(f))
 	cobraCmd.AddCommand(delete.NewCmd(f))
 	cobraCmd.AddCommand(update.NewCmd(f))
+	cobraCmd.AddCommand(apply.NewCmd(f))
+	cobraCmd.AddCommand(export.NewCmd(f))
+	cobraCmd.AddCommand(diff.NewCmd(f))
+	cobraCmd.AddCommand(profile.NewCmd(f))
+	cobraCmd.AddCommand(login.NewCmd(f))
+	cobraCmd.AddCommand(logout.NewCmd(f))
+	cobraCmd.AddCommand(whoami.NewCmd(f))
+	cobraCmd.AddCommand(credentials.NewCmd(f))
+	cobraCmd.AddCommand(configcmd.NewCmd(f))
 
 	return cobraCmd
 }
@@ -137,17 +187,25 @@ func (cmd *RootCmd) Run() error {
 
 func Execute() {
 	streams := iostreams.System()
+	color.NoColor = !streams.ColorEnabled()
 	httpClient := &http.Client{
-		Timeout: 10 * time.Second, // TODO: Configure this somewhere
+		// the http_timeout setting replaces it before the command runs
+		Timeout: 30 * time.Second,
 	}
 
 	// TODO: Ignoring errors since the file might not exist, maybe warn the user?
-	tok, _ := token.ReadFromDisk()
+	tok, _ := token.ReadSaved()
 	viper.SetEnvPrefix("AZIONCLI")
 	viper.AutomaticEnv()
 	viper.SetDefault("token", tok)
 	viper.SetDefault("api_url", constants.ApiURL)
 	viper.SetDefault("storage_url", constants.StorageApiURL)
+	viper.SetDefault("auth_url", constants.AuthURL)
+	for _, setting := range config.Settings {
+		if setting.Default != "" {
+			viper.SetDefault(setting.Key, setting.Default)
+		}
+	}
 
 	factory := &cmdutil.Factory{
 		HttpClient: httpClient,
@@ -157,5 +215,37 @@ func Execute() {
 
 	cmd := NewCmd(factory)
 
-	cobra.CheckErr(cmd.Execute())
+	c, err := cmd.ExecuteC()
+	_ = logger.CloseFile(zap.Int("exit_code", utils.ExitCode(err)), zap.Error(err))
+	_ = closeTrace()
+	if err != nil {
+		printError(factory, c, err)
+		os.Exit(utils.ExitCode(err))
+	}
+}
+
+// printError writes err on stderr as cobra does, or as a JSON object when the output of the command
+// is JSON, so scripts can read its code, HTTP status and request ID
+func printError(f *cmdutil.Factory, cmd *cobra.Command, err error) {
+	if outputFormat(f, cmd) == "json" {
+		data, _ := json.Marshal(utils.AsError(err))
+		fmt.Fprintln(f.IOStreams.Err, string(data))
+		return
+	}
+	fmt.Fprintln(f.IOStreams.Err, "Error:", err)
+}
+
+// outputFormat returns the format of commands with a --format flag, which is sent or the output_format setting
+func outputFormat(f *cmdutil.Factory, cmd *cobra.Command) string {
+	if cmd == nil {
+		return ""
+	}
+	flag := cmd.Flags().Lookup("format")
+	if flag == nil {
+		return ""
+	}
+	if flag.Changed {
+		return flag.Value.String()
+	}
+	return f.Config.GetString("output_format")
 }
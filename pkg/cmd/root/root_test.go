@@ -0,0 +1,64 @@
+package root
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintError(t *testing.T) {
+	newCmd := func() *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("format", "", "")
+		return cmd
+	}
+	notFound := utils.ErrorPerStatusCode(&http.Response{StatusCode: 404, Header: http.Header{"X-Request-Id": {"req-1"}}}, nil)
+
+	t.Run("text", func(t *testing.T) {
+		f, _, stderr := testutils.NewFactory(nil)
+		printError(f, newCmd(), notFound)
+		require.Equal(t, "Error: "+utils.ErrorNotFound404.Error()+"\n", stderr.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		f, _, stderr := testutils.NewFactory(nil)
+		cmd := newCmd()
+		require.NoError(t, cmd.Flags().Set("format", "json"))
+		printError(f, cmd, notFound)
+		require.JSONEq(t, `{"code": "not_found", "message": "`+utils.ErrorNotFound404.Error()+`", "status": 404, "request_id": "req-1", "exit_code": 4}`, stderr.String())
+	})
+
+	t.Run("json from the output_format setting", func(t *testing.T) {
+		f, _, stderr := testutils.NewFactory(nil)
+		f.Config.SetDefault("output_format", "json")
+		printError(f, newCmd(), notFound)
+		require.Contains(t, stderr.String(), `"code":"not_found"`)
+	})
+}
+
+func TestFlagErrors(t *testing.T) {
+	f, _, _ := testutils.NewFactory(nil)
+	cmd := NewCmd(f)
+	cmd.SetArgs([]string{"--unknown-flag"})
+	_, err := cmd.ExecuteC()
+	require.Error(t, err)
+	require.Equal(t, utils.ExitValidation, utils.ExitCode(err))
+}
+
+func TestVerbNounCommands(t *testing.T) {
+	f, _, _ := testutils.NewFactory(nil)
+	cmd := NewCmd(f)
+
+	nouns := []string{"origin", "cache-setting", "edge-function", "edge-function-instance", "device-group", "variable", "edge-service", "edge-service-resource"}
+	for _, verb := range []string{"create", "list", "describe", "update", "delete"} {
+		for _, noun := range nouns {
+			found, _, err := cmd.Find([]string{verb, noun})
+			require.NoError(t, err, verb+" "+noun)
+			require.Equal(t, verb+" "+noun, found.Parent().Name()+" "+found.Name())
+		}
+	}
+}
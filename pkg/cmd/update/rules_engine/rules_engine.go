@@ -62,7 +62,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.Update(context.Background(), &reqSdk)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorUpdate.Error(), err)
+				return utils.WrapError(msg.ErrorUpdate, err)
 			}
 
 			logger.LogSuccess(f.IOStreams.Out, fmt.Sprintf(msg.OutputSuccess, response.GetId()))
@@ -7,6 +7,14 @@ import (
 	edgeApplication "github.com/aziontech/azion-cli/pkg/cmd/update/edge_application"
 	rulesEngine "github.com/aziontech/azion-cli/pkg/cmd/update/rules_engine"
 
+	cacheSettings "github.com/aziontech/azion-cli/pkg/cmd/cache_settings/update"
+	deviceGroups "github.com/aziontech/azion-cli/pkg/cmd/device_groups/update"
+	edgeFunctions "github.com/aziontech/azion-cli/pkg/cmd/edge_functions/update"
+	functionInstances "github.com/aziontech/azion-cli/pkg/cmd/edge_functions_instances/update"
+	serviceResources "github.com/aziontech/azion-cli/pkg/cmd/edge_services/resources/update"
+	edgeServices "github.com/aziontech/azion-cli/pkg/cmd/edge_services/update"
+	origins "github.com/aziontech/azion-cli/pkg/cmd/origins/update"
+	variables "github.com/aziontech/azion-cli/pkg/cmd/variables/update"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -17,6 +25,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		Short: msg.ShortDescription,
 		Long:  msg.LongDescription, Example: heredoc.Doc(`
 		$ azion update --help
+		$ azion update variable --variable-id 7a187044-4a00-4a4a-93ed-d230900421f3 --key "Content-Type" --value "text" --secret false
 		$ azion update edge-application
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -27,6 +36,14 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(edgeApplication.NewCmd(f))
 	cmd.AddCommand(rulesEngine.NewCmd(f))
 	cmd.AddCommand(domains.NewCmd(f))
+	cmd.AddCommand(origins.NewCmd(f))
+	cmd.AddCommand(cacheSettings.NewCmd(f))
+	cmd.AddCommand(edgeFunctions.NewCmd(f))
+	cmd.AddCommand(functionInstances.NewCmd(f))
+	cmd.AddCommand(deviceGroups.NewCmd(f))
+	cmd.AddCommand(variables.NewCmd(f))
+	cmd.AddCommand(edgeServices.NewCmd(f))
+	cmd.AddCommand(serviceResources.NewCmd(f))
 
 	cmd.Flags().BoolP("help", "h", false, msg.FlagHelp)
 	return cmd
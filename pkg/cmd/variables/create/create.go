@@ -33,8 +33,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-	$ azion variables create --key "Content-Type" --value "string" --secret false
-	$ azion variables create --in "create.json"
+	$ azion create variable --key "Content-Type" --value "string" --secret false
+	$ azion create variable --in "create.json"
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -77,7 +77,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
 			response, err := client.Create(context.Background(), request)
 			if err != nil {
-				return fmt.Errorf(msg.ErrorCreateItem.Error(), err)
+				return utils.WrapError(msg.ErrorCreateItem, err)
 			}
 			fmt.Fprintf(f.IOStreams.Out, msg.CreateOutputSuccess, response.GetUuid())
 			return nil
@@ -8,6 +8,7 @@ import (
 	msg "github.com/aziontech/azion-cli/messages/variables"
 	api "github.com/aziontech/azion-cli/pkg/api/variables"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -21,8 +22,8 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceErrors: true,
 		SilenceUsage:  true,
 		Example: heredoc.Doc(`
-		$ azion variables delete --variable-id 7a187044-4a00-4a4a-93ed-d230900421f3
-		$ azion variables delete -v 7a187044-4a00-4a4a-93ed-d230900421f3
+		$ azion delete variable --variable-id 7a187044-4a00-4a4a-93ed-d230900421f3
+		$ azion delete variable -v 7a187044-4a00-4a4a-93ed-d230900421f3
 		`),
 
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -37,7 +38,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			err := client.Delete(ctx, variable_id)
 
 			if err != nil {
-				return fmt.Errorf(msg.ErrorFailToDeleteVariable.Error(), err)
+				return utils.WrapError(msg.ErrorFailToDeleteVariable, err)
 			}
 			out := f.IOStreams.Out
 			fmt.Fprintf(out, msg.DeleteOutputSuccess, variable_id)
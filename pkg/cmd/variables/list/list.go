@@ -2,18 +2,13 @@ package list
 
 import (
 	"context"
-	"strings"
-
-	"github.com/aziontech/azion-cli/utils"
 
 	"github.com/MakeNowJust/heredoc"
-	table "github.com/MaxwelMazur/tablecli"
 	"github.com/aziontech/azion-cli/messages/general"
 	msg "github.com/aziontech/azion-cli/messages/variables"
 	api "github.com/aziontech/azion-cli/pkg/api/variables"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
-	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 )
 
@@ -27,8 +22,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion variables list --details
-		$ azion variables list
+		$ azion list variable --details
+		$ azion list variable
+		$ azion list variable --format yaml
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client := api.NewClient(f.HttpClient, f.Config.GetString("api_url"), f.Config.GetString("token"))
@@ -41,6 +37,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	listCmd.Flags().BoolVar(&opts.Details, "details", false, general.ApiListFlagDetails)
+	cmdutil.AddOutputFlags(listCmd, &opts.OutputOptions)
 	listCmd.Flags().BoolP("help", "h", false, msg.VariablesListHelpFlag)
 	return listCmd
 }
@@ -53,28 +50,22 @@ func listAllVariables(client *api.Client, f *cmdutil.Factory, opts *contracts.Li
 		return err
 	}
 
-	tbl := table.New("ID", "KEY", "VALUE")
-	tbl.WithWriter(f.IOStreams.Out)
-
+	headers := []string{"ID", "KEY", "VALUE"}
 	if opts.Details {
-		tbl = table.New("ID", "KEY", "VALUE", "SECRET", "LAST EDITOR")
+		headers = append(headers, "SECRET", "LAST EDITOR")
 	}
 
-	headerFmt := color.New(color.FgBlue, color.Underline).SprintfFunc()
-	columnFmt := color.New(color.FgGreen).SprintfFunc()
-	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
+	p, err := cmdutil.NewPrinter(f, &opts.OutputOptions, headers...)
+	if err != nil {
+		return err
+	}
 
 	for _, v := range resp {
-		tbl.AddRow(v.GetUuid(), v.GetKey(), utils.TruncateString(v.GetValue()), v.GetSecret(), v.GetLastEditor())
+		p.AddRow(v, v.GetUuid(), v.GetKey(), v.GetValue(), v.GetSecret(), v.GetLastEditor())
 	}
 
-	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
-	tbl.CalculateWidths([]string{})
-	tbl.PrintHeader(format)
-
-	for _, row := range tbl.GetRows() {
-		tbl.PrintRow(format, row)
+	if err := p.Flush(); err != nil {
+		return err
 	}
-
-	return nil
+	return p.Close()
 }
@@ -32,9 +32,9 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Example: heredoc.Doc(`
-		$ azion variables update --variable-id 7a187044-4a00-4a4a-93ed-d230900421f3 --key 'Content-Type' --value 'json' --secret false
-		$ azion variables update -v 7a187044-4a00-4a4a-93ed-d230900421f3 --key 'Content-Type' --value 'json' --secret false
-		$ azion variables update --in variables.json
+		$ azion update variable --variable-id 7a187044-4a00-4a4a-93ed-d230900421f3 --key 'Content-Type' --value 'json' --secret false
+		$ azion update variable -v 7a187044-4a00-4a4a-93ed-d230900421f3 --key 'Content-Type' --value 'json' --secret false
+		$ azion update variable --in variables.json
         `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// either function-id or in path should be passed
@@ -79,7 +79,7 @@ func NewCmd(f *cmdutil.Factory) *cobra.Command {
 			response, err := client.Update(ctx, &request)
 
 			if err != nil {
-				return fmt.Errorf(msg.ErrorUpdateVariable.Error(), err)
+				return utils.WrapError(msg.ErrorUpdateVariable, err)
 			}
 
 			fmt.Fprintf(f.IOStreams.Out, "Updated Variable with ID %s\n", response.GetUuid())
@@ -0,0 +1,102 @@
+package whoami
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	msg "github.com/aziontech/azion-cli/messages/whoami"
+	"github.com/aziontech/azion-cli/pkg/api/client"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/token"
+	"github.com/aziontech/azion-cli/utils"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+func NewCmd(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           msg.WhoamiUsage,
+		Short:         msg.WhoamiShortDescription,
+		Long:          msg.WhoamiLongDescription,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Example: heredoc.Doc(`
+		$ azion whoami
+		$ azion whoami --profile stage
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger.Debug("Running whoami command")
+
+			tok := f.Config.GetString("token")
+			if tok == "" {
+				return msg.ErrorNotLoggedIn
+			}
+			tok, err := client.RevealToken(tok)
+			if err != nil {
+				return err
+			}
+
+			t, err := token.New(&token.Config{
+				Client:   f.HttpClient,
+				Out:      f.IOStreams.Out,
+				Endpoint: f.Config.GetString("auth_url"),
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", utils.ErrorTokenManager, err)
+			}
+
+			user, err := t.User(tok)
+			if err != nil {
+				logger.Debug("Error while getting user", zap.Error(err))
+				return utils.WrapError(msg.ErrorGetUser, err)
+			}
+			if user == nil {
+				return utils.ErrorInvalidToken
+			}
+
+			out := f.IOStreams.Out
+			name := strings.TrimSpace(user.FirstName + " " + user.LastName)
+			if name != "" {
+				logger.FInfo(out, fmt.Sprintf(msg.WhoamiName, name))
+			}
+			if user.Email != "" {
+				logger.FInfo(out, fmt.Sprintf(msg.WhoamiEmail, user.Email))
+			}
+			if user.AccountName != "" {
+				logger.FInfo(out, fmt.Sprintf(msg.WhoamiAccount, user.AccountName))
+			}
+			if user.ClientID != "" {
+				logger.FInfo(out, fmt.Sprintf(msg.WhoamiClientID, user.ClientID))
+			}
+			logger.FInfo(out, fmt.Sprintf(msg.WhoamiProfile, f.Profile))
+			logger.FInfo(out, fmt.Sprintf(msg.WhoamiTokenSource, tokenSource(cmd, f)))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolP("help", "h", false, msg.WhoamiFlagHelp)
+	return cmd
+}
+
+// tokenSource describes where the token in use came from, following the precedence of the root command
+func tokenSource(cmd *cobra.Command, f *cmdutil.Factory) string {
+	if cmd.Flags().Changed("token") {
+		return msg.WhoamiSourceFlag
+	}
+	if os.Getenv("AZIONCLI_TOKEN") != "" {
+		return msg.WhoamiSourceEnv
+	}
+
+	profiles, err := config.ReadProfiles()
+	if err == nil && profiles.Profiles[f.Profile].Token != "" {
+		return fmt.Sprintf(msg.WhoamiSourceProfile, f.Profile)
+	}
+
+	path, _ := token.FilePath()
+	return fmt.Sprintf(msg.WhoamiSourceFile, path)
+}
@@ -0,0 +1,50 @@
+package whoami
+
+import (
+	"net/http"
+	"testing"
+
+	msg "github.com/aziontech/azion-cli/messages/whoami"
+	"github.com/aziontech/azion-cli/pkg/config"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestWhoami(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	config.SetPath(t.TempDir())
+	defer config.SetPath(".azion")
+
+	t.Run("show user", func(t *testing.T) {
+		mock := &httpmock.Registry{}
+		mock.Register(
+			httpmock.REST("GET", "api/user/me"),
+			httpmock.StatusStringResponse(http.StatusOK, `{"results": {"email": "suupa@azion.com", "first_name": "Suupa", "last_name": "Doopa", "client_id": "1234a"}}`),
+		)
+
+		f, stdout, _ := testutils.NewFactory(mock)
+		f.Profile = config.DefaultProfile
+		f.Config.SetDefault("auth_url", "https://sso.azion.com/api/user/me")
+		f.Config.SetDefault("token", "azionb43a9554776")
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+		_, err := cmd.ExecuteC()
+		require.NoError(t, err)
+		require.Contains(t, stdout.String(), "Suupa Doopa")
+		require.Contains(t, stdout.String(), "suupa@azion.com")
+		require.Contains(t, stdout.String(), "Token source: file")
+	})
+
+	t.Run("not logged in", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(nil)
+
+		cmd := NewCmd(f)
+		cmd.SetArgs([]string{})
+		_, err := cmd.ExecuteC()
+		require.ErrorIs(t, err, msg.ErrorNotLoggedIn)
+	})
+}
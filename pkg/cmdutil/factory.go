@@ -14,4 +14,13 @@ type Factory struct {
 	Config     config.Config
 	logger.Logger
 	GlobalFlagAll bool
+	NoInput       bool
+	// Profile is the name of the profile whose credentials and endpoints are in use
+	Profile string
+}
+
+// CanPrompt reports whether commands may ask the user for input. It is false when
+// --no-input was sent or when the standard input isn't a terminal
+func (f *Factory) CanPrompt() bool {
+	return !f.NoInput && f.IOStreams.IsStdinTTY()
 }
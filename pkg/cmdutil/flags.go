@@ -0,0 +1,16 @@
+package cmdutil
+
+import "github.com/spf13/cobra"
+
+// BoolFlag returns the value of a boolean flag, or nil when the flag was not sent,
+// so commands can tell a "false" answer apart from a missing one
+func BoolFlag(cmd *cobra.Command, name string) *bool {
+	if !cmd.Flags().Changed(name) {
+		return nil
+	}
+	value, err := cmd.Flags().GetBool(name)
+	if err != nil {
+		return nil
+	}
+	return &value
+}
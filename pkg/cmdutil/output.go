@@ -0,0 +1,71 @@
+package cmdutil
+
+import (
+	"fmt"
+	"path/filepath"
+
+	msg "github.com/aziontech/azion-cli/messages/general"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/printer"
+	"github.com/spf13/cobra"
+)
+
+// AddOutputFlags registers the --format, --out, --fields, --query and --template flags of list and
+// describe commands
+func AddOutputFlags(cmd *cobra.Command, opts *contracts.OutputOptions) {
+	cmd.Flags().StringVar(&opts.Format, "format", "", msg.FlagFormat)
+	cmd.Flags().StringVar(&opts.OutPath, "out", "", msg.FlagOut)
+	cmd.Flags().StringSliceVar(&opts.Fields, "fields", nil, msg.FlagFields)
+	cmd.Flags().StringVar(&opts.Query, "query", "", msg.FlagQuery)
+	cmd.Flags().StringVar(&opts.Template, "template", "", msg.FlagTemplate)
+}
+
+// NewPrinter returns the printer of list and describe commands. The format is the one sent with
+// --format, json when only --out is sent, or the output_format setting. The output goes to the
+// --out file when it is sent, and --fields, --query and --template change what is written. Tables on
+// a terminal are colored as IOStreams allows and fit its width
+func NewPrinter(f *Factory, opts *contracts.OutputOptions, headers ...string) (*printer.Printer, error) {
+	if opts.Template != "" && (len(opts.Fields) > 0 || opts.Query != "") {
+		return nil, msg.ErrorTmplConflict
+	}
+
+	format, outPath := opts.Format, opts.OutPath
+	if format == "" {
+		format = f.Config.GetString("output_format")
+		if outPath != "" {
+			format = printer.FormatJSON
+		}
+	}
+	if format == "" {
+		format = printer.FormatTable
+	}
+
+	p, err := printer.New(f.IOStreams.Out, format, headers...)
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorInvalidFormat.Error(), format)
+	}
+
+	// the query and the template are checked before the --out file is created, so a mistake leaves no file behind
+	p.Select(opts.Fields)
+	if opts.Query != "" {
+		if err := p.SetQuery(opts.Query); err != nil {
+			return nil, fmt.Errorf(msg.ErrorInvalidQuery.Error(), err)
+		}
+	}
+	if opts.Template != "" {
+		if err := p.SetTemplate(opts.Template); err != nil {
+			return nil, fmt.Errorf(msg.ErrorInvalidTmpl.Error(), err)
+		}
+	}
+
+	if outPath == "" {
+		p.Color = f.IOStreams.ColorEnabled()
+		p.Width = f.IOStreams.TerminalWidth()
+	} else if err := p.OpenFile(outPath); err != nil {
+		return nil, fmt.Errorf(msg.ErrorOpenOutput.Error(), outPath, err)
+	}
+	p.Written = func(path string) {
+		fmt.Fprintf(f.IOStreams.Out, msg.FileWritten, filepath.Clean(path))
+	}
+	return p, nil
+}
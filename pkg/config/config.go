@@ -11,6 +11,7 @@ var pathDir string = defaultPath
 
 type Config interface {
 	GetString(key string) string
+	SetDefault(key string, value interface{})
 }
 
 func SetPath(cp string) {
@@ -0,0 +1,51 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+// preferencesFilename kept the package manager chosen by the user before the package_manager setting
+const preferencesFilename = "preferences.json"
+
+// MigratePreferences moves the package manager of the preferences.json file of the config directory
+// to the package_manager setting, unless the setting is already set, and removes the file.
+// It does nothing when the file does not exist
+func MigratePreferences() error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, preferencesFilename)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	prefs := struct {
+		PackageManager string `json:"package_manager"`
+	}{}
+	if err := json.Unmarshal(data, &prefs); err != nil {
+		return err
+	}
+
+	if prefs.PackageManager != "" {
+		settings, err := ReadSettings()
+		if err != nil {
+			return err
+		}
+		if _, ok := settings["package_manager"]; !ok {
+			if err := SaveSetting("package_manager", prefs.PackageManager); err != nil {
+				return err
+			}
+		}
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v2"
+)
+
+const profilesFilename = "profiles.yaml"
+
+// DefaultProfile is used when no profile is chosen. Its token falls back to the credentials file
+const DefaultProfile = "default"
+
+// Profile holds the credentials and endpoints of an Azion account or environment
+type Profile struct {
+	Token      string            `yaml:"token,omitempty"`
+	ApiURL     string            `yaml:"api_url,omitempty"`
+	StorageURL string            `yaml:"storage_url,omitempty"`
+	Defaults   map[string]string `yaml:"defaults,omitempty"`
+}
+
+// Profiles is the content of the profiles.yaml file of the config directory
+type Profiles struct {
+	Current  string             `yaml:"current,omitempty"`
+	Profiles map[string]Profile `yaml:"profiles,omitempty"`
+}
+
+// ReadProfiles reads the profiles.yaml file of the config directory.
+// It returns no profiles and no error when the file does not exist
+func ReadProfiles() (*Profiles, error) {
+	profiles := &Profiles{Profiles: map[string]Profile{}}
+
+	dir, err := Dir()
+	if err != nil {
+		return profiles, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, profilesFilename))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return profiles, nil
+		}
+		return profiles, err
+	}
+
+	if err := yaml.Unmarshal(data, profiles); err != nil {
+		return profiles, err
+	}
+	if profiles.Profiles == nil {
+		profiles.Profiles = map[string]Profile{}
+	}
+
+	return profiles, nil
+}
+
+// WriteProfiles stores the profiles in the config directory. The file is only readable by the user, since it has tokens
+func WriteProfiles(profiles *Profiles) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(profiles)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, profilesFilename), data, 0600)
+}
+
+// CurrentName returns the profile in use when none is chosen through flags or environment variables
+func (p *Profiles) CurrentName() string {
+	if p.Current == "" {
+		return DefaultProfile
+	}
+	return p.Current
+}
+
+// Exists reports whether the profile is configured. The default profile always exists
+func (p *Profiles) Exists(name string) bool {
+	_, ok := p.Profiles[name]
+	return ok || name == DefaultProfile
+}
+
+// Names returns the configured profiles, including the default one, sorted by name
+func (p *Profiles) Names() []string {
+	names := []string{DefaultProfile}
+	for name := range p.Profiles {
+		if name != DefaultProfile {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names[1:])
+	return names
+}
@@ -0,0 +1,191 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v2"
+)
+
+const settingsFilename = "config.yaml"
+
+// Setting is a key accepted by the config.yaml file of the config directory
+type Setting struct {
+	Key         string
+	Description string
+	// Default is used when neither the file, the profile nor the environment sets the key
+	Default  string
+	validate func(value string) error
+}
+
+// Settings are the keys accepted by the config.yaml file
+var Settings = []Setting{
+	{Key: "api_url", Description: "URL of the Azion API", validate: validateURL},
+	{Key: "storage_url", Description: "URL of the Azion storage API", validate: validateURL},
+	{Key: "http_timeout", Description: "Timeout of each request to the Azion APIs, like 30s or 1m", Default: "30s", validate: validateDuration},
+	{Key: "proxy", Description: "URL of the proxy used to reach the Azion APIs. HTTPS_PROXY is used when empty", validate: validateURL},
+	{Key: "ca_bundle", Description: "Path of a PEM file with extra certificate authorities trusted by the CLI", validate: validateFile},
+	{Key: "output_format", Description: "Format of the output of list and describe commands", Default: "table", validate: oneOf("table", "json", "yaml", "csv")},
+	{Key: "color", Description: "Whether the output is colored. auto colors terminals unless NO_COLOR is set", Default: "auto", validate: oneOf("auto", "always", "never")},
+	{Key: "log_file", Description: "Path of a JSON file that keeps every log entry of each command, at debug level, or true for logs/azion.log in the config directory"},
+	{Key: "upload_concurrency", Description: "Number of files uploaded at the same time by deploy", Default: "5", validate: validatePositive},
+	{Key: "update_check", Description: "Whether the CLI checks for new versions", Default: "true", validate: validateBool},
+	{Key: "update_check_interval", Description: "Time between checks for new versions, like 24h", Default: "24h", validate: validateDuration},
+	{Key: "package_manager", Description: "Package manager used when none is detected in the project. It is set to the one chosen when the CLI asks for it", validate: oneOf("npm", "yarn", "pnpm", "bun")},
+}
+
+// LookupSetting returns the setting of the key, which may also be a templates.<name>.<field> key
+func LookupSetting(key string) (Setting, bool) {
+	for _, s := range Settings {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return lookupTemplateSetting(key)
+}
+
+// Validate returns why the value is not accepted by the setting
+func (s Setting) Validate(value string) error {
+	if s.validate == nil {
+		return nil
+	}
+	return s.validate(value)
+}
+
+// SettingsPath returns the path of the config.yaml file
+func SettingsPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, settingsFilename), nil
+}
+
+// LogFilePath returns the path of the log file written with --log-file or the log_file setting set to true
+func LogFilePath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "logs", "azion.log"), nil
+}
+
+// ReadSettings loads the config.yaml file of the config directory.
+// It returns no settings and no error when the file does not exist
+func ReadSettings() (map[string]string, error) {
+	settings := map[string]string{}
+
+	path, err := SettingsPath()
+	if err != nil {
+		return settings, err
+	}
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return settings, nil
+	}
+
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		return settings, err
+	}
+
+	for _, key := range v.AllKeys() {
+		settings[key] = v.GetString(key)
+	}
+	return settings, nil
+}
+
+// WriteSettings stores the settings in the config.yaml file of the config directory
+func WriteSettings(settings map[string]string) error {
+	path, err := SettingsPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0600)
+}
+
+// SaveSetting sets the key in the config.yaml file of the config directory, keeping the other settings
+func SaveSetting(key, value string) error {
+	settings, err := ReadSettings()
+	if err != nil {
+		return err
+	}
+	settings[key] = value
+	return WriteSettings(settings)
+}
+
+func validateURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return errors.New("it must be an http or https URL")
+	}
+	return nil
+}
+
+func validateFile(value string) error {
+	info, err := os.Stat(value)
+	if err != nil || info.IsDir() {
+		return errors.New("it must be the path of an existing file")
+	}
+	return nil
+}
+
+func validateDuration(value string) error {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return errors.New("it must be a positive duration, like 30s, 5m or 24h")
+	}
+	return nil
+}
+
+func validatePositive(value string) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		return errors.New("it must be a positive number")
+	}
+	return nil
+}
+
+func validateNotEmpty(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return errors.New("it must not be empty")
+	}
+	return nil
+}
+
+func validateBool(value string) error {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return errors.New("it must be true or false")
+	}
+	return nil
+}
+
+func oneOf(values ...string) func(string) error {
+	return func(value string) error {
+		for _, v := range values {
+			if value == v {
+				return nil
+			}
+		}
+		return fmt.Errorf("it must be one of %s", strings.Join(values, ", "))
+	}
+}
@@ -0,0 +1,167 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// templatesPrefix starts the keys of the template sources in the config.yaml file,
+// like templates.<name>.repo
+const templatesPrefix = "templates."
+
+// templatesFilename kept the template sources before they moved to the config.yaml file
+const templatesFilename = "templates.json"
+
+// TemplateSource is a starter template repository or local directory that can be used by 'azion init'
+type TemplateSource struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Repo        string `json:"repo"`
+	Ref         string `json:"ref,omitempty"`
+	Subdir      string `json:"subdir,omitempty"`
+}
+
+// templateSettings are the fields accepted for each template source, keyed as templates.<name>.<field>
+var templateSettings = []Setting{
+	{Key: "repo", Description: "Git repository or local directory of the template source %s", validate: validateNotEmpty},
+	{Key: "ref", Description: "Branch, tag or commit of the template source %s"},
+	{Key: "subdir", Description: "Directory of the repository that holds the template source %s"},
+	{Key: "description", Description: "Description of the template source %s"},
+}
+
+// lookupTemplateSetting returns the setting of a templates.<name>.<field> key.
+// The key is lowercased, since the config.yaml file is read without case
+func lookupTemplateSetting(key string) (Setting, bool) {
+	key = strings.ToLower(key)
+	parts := strings.Split(key, ".")
+	if len(parts) != 3 || parts[0]+"." != templatesPrefix || parts[1] == "" {
+		return Setting{}, false
+	}
+
+	for _, s := range templateSettings {
+		if s.Key == parts[2] {
+			s.Key = key
+			s.Description = strings.Replace(s.Description, "%s", parts[1], 1)
+			return s, true
+		}
+	}
+	return Setting{}, false
+}
+
+// IsTemplateKey reports whether the key belongs to a template source
+func IsTemplateKey(key string) bool {
+	return strings.HasPrefix(strings.ToLower(key), templatesPrefix)
+}
+
+// TemplateSources reads the template sources configured in the config.yaml file of the config directory,
+// sorted by name. Sources without a repo are left out
+func TemplateSources() ([]TemplateSource, error) {
+	settings, err := ReadSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	byName := map[string]*TemplateSource{}
+	for key, value := range settings {
+		if !IsTemplateKey(key) {
+			continue
+		}
+		parts := strings.Split(key, ".")
+		if len(parts) != 3 {
+			continue
+		}
+
+		source, ok := byName[parts[1]]
+		if !ok {
+			source = &TemplateSource{Name: parts[1]}
+			byName[parts[1]] = source
+		}
+		switch parts[2] {
+		case "repo":
+			source.Repo = value
+		case "ref":
+			source.Ref = value
+		case "subdir":
+			source.Subdir = value
+		case "description":
+			source.Description = value
+		}
+	}
+
+	var sources []TemplateSource
+	for _, source := range byName {
+		if source.Repo != "" {
+			sources = append(sources, *source)
+		}
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Name < sources[j].Name })
+	return sources, nil
+}
+
+// MigrateTemplateSources moves the sources of the templates.json file of the config directory
+// to templates.<name> keys of the config.yaml file, keeping the keys already set, and removes the file.
+// It does nothing when the file does not exist
+func MigrateTemplateSources() error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, templatesFilename)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	var sources []TemplateSource
+	if err := json.Unmarshal(data, &sources); err != nil {
+		return err
+	}
+
+	settings, err := ReadSettings()
+	if err != nil {
+		return err
+	}
+
+	for _, source := range sources {
+		name := strings.ToLower(source.Name)
+		if name == "" || strings.Contains(name, ".") {
+			continue
+		}
+		fields := map[string]string{
+			"repo":        source.Repo,
+			"ref":         source.Ref,
+			"subdir":      source.Subdir,
+			"description": source.Description,
+		}
+		for field, value := range fields {
+			key := templatesPrefix + name + "." + field
+			if _, ok := settings[key]; !ok && value != "" {
+				settings[key] = value
+			}
+		}
+	}
+
+	if err := WriteSettings(settings); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+const templateCacheDirname = "templates"
+
+// TemplateCacheDir returns the directory where 'azion init --refresh-templates' keeps templates for offline use
+func TemplateCacheDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, templateCacheDirname), nil
+}
@@ -16,11 +16,20 @@ type ListOptions struct {
 	Page     int64
 	PageSize int64
 	Filter   string
+	OutputOptions
 }
 
 type DescribeOptions struct {
-	OutPath string
-	Format  string
+	OutputOptions
+}
+
+// OutputOptions are the flags that choose how list and describe commands write their output
+type OutputOptions struct {
+	Format   string
+	OutPath  string
+	Fields   []string
+	Query    string
+	Template string
 }
 
 type AzionApplicationOptions struct {
@@ -36,6 +45,18 @@ type AzionApplicationOptions struct {
 	Domain      AzionJsonDataDomain      `json:"domain"`
 	RtPurge     AzionJsonDataPurge       `json:"rt-purge"`
 	Origin      AzionJsonDataOrigin      `json:"origin"`
+	Build       BuildConf                `json:"build"`
+}
+
+// TemplateManifest is read from the azion.template.json file of a custom starter template
+type TemplateManifest struct {
+	Presets []TemplatePreset        `json:"presets"`
+	Azion   AzionApplicationOptions `json:"azion"`
+}
+
+type TemplatePreset struct {
+	Name  string   `json:"name"`
+	Modes []string `json:"modes"`
 }
 
 type AzionApplicationSimple struct {
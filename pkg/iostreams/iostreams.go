@@ -3,18 +3,124 @@ package iostreams
 import (
 	"io"
 	"os"
+	"strconv"
+
+	"golang.org/x/term"
 )
 
+// DefaultWidth is the width of terminals whose size cannot be read
+const DefaultWidth = 80
+
 type IOStreams struct {
 	In  io.ReadCloser
 	Out io.Writer
 	Err io.Writer
+
+	stdinTTYOverride bool
+	stdinIsTTY       bool
+
+	stdoutTTYOverride bool
+	stdoutIsTTY       bool
+
+	colorEnabled bool
+	termWidth    int
 }
 
+// System returns the streams of the process. Output is colored when stdout is a terminal,
+// unless NO_COLOR is set, or always when CLICOLOR_FORCE is set
 func System() *IOStreams {
-	return &IOStreams{
+	s := &IOStreams{
 		In:  os.Stdin,
 		Out: os.Stdout,
 		Err: os.Stderr,
 	}
+	s.colorEnabled = !EnvColorDisabled() && (EnvColorForced() || s.IsStdoutTTY())
+	return s
+}
+
+// EnvColorDisabled reports whether NO_COLOR asks for output without colors, see https://no-color.org
+func EnvColorDisabled() bool {
+	return os.Getenv("NO_COLOR") != ""
+}
+
+// EnvColorForced reports whether CLICOLOR_FORCE asks for colors even when the output isn't a terminal
+func EnvColorForced() bool {
+	force, ok := os.LookupEnv("CLICOLOR_FORCE")
+	return ok && force != "" && force != "0"
+}
+
+// IsStdinTTY reports whether the input stream is an interactive terminal
+func (s *IOStreams) IsStdinTTY() bool {
+	if s.stdinTTYOverride {
+		return s.stdinIsTTY
+	}
+	return isTerminal(s.In)
+}
+
+// SetStdinTTY overrides the terminal detection of the input stream, mostly for tests
+func (s *IOStreams) SetStdinTTY(isTTY bool) {
+	s.stdinTTYOverride = true
+	s.stdinIsTTY = isTTY
+}
+
+// IsStdoutTTY reports whether the output stream is an interactive terminal
+func (s *IOStreams) IsStdoutTTY() bool {
+	if s.stdoutTTYOverride {
+		return s.stdoutIsTTY
+	}
+	return isTerminal(s.Out)
+}
+
+// SetStdoutTTY overrides the terminal detection of the output stream, mostly for tests
+func (s *IOStreams) SetStdoutTTY(isTTY bool) {
+	s.stdoutTTYOverride = true
+	s.stdoutIsTTY = isTTY
+}
+
+// IsStderrTTY reports whether the error stream is an interactive terminal
+func (s *IOStreams) IsStderrTTY() bool {
+	return isTerminal(s.Err)
+}
+
+// ColorEnabled reports whether the output may use colors and other escape codes
+func (s *IOStreams) ColorEnabled() bool {
+	return s.colorEnabled
+}
+
+// SetColorEnabled turns colors on or off, as --no-color and the color setting do
+func (s *IOStreams) SetColorEnabled(enabled bool) {
+	s.colorEnabled = enabled
+}
+
+// TerminalWidth returns the number of columns of the output terminal, or 0 when the output isn't
+// a terminal and so has no width. COLUMNS is used when the size cannot be read
+func (s *IOStreams) TerminalWidth() int {
+	if s.termWidth > 0 {
+		return s.termWidth
+	}
+	if !s.IsStdoutTTY() {
+		return 0
+	}
+	if f, ok := s.Out.(*os.File); ok {
+		if width, _, err := term.GetSize(int(f.Fd())); err == nil && width > 0 {
+			return width
+		}
+	}
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+	return DefaultWidth
+}
+
+// SetTerminalWidth overrides the width of the output terminal, mostly for tests
+func (s *IOStreams) SetTerminalWidth(width int) {
+	s.termWidth = width
+}
+
+func isTerminal(stream interface{}) bool {
+	f, ok := stream.(*os.File)
+	if !ok {
+		return false
+	}
+	return term.IsTerminal(int(f.Fd()))
 }
@@ -0,0 +1,45 @@
+package iostreams
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestColorEnv(t *testing.T) {
+	t.Run("NO_COLOR disables colors", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "1")
+		t.Setenv("CLICOLOR_FORCE", "1")
+		require.True(t, EnvColorDisabled())
+		require.False(t, System().ColorEnabled())
+	})
+
+	t.Run("CLICOLOR_FORCE keeps colors without a terminal", func(t *testing.T) {
+		t.Setenv("NO_COLOR", "")
+		t.Setenv("CLICOLOR_FORCE", "1")
+		require.True(t, EnvColorForced())
+		require.True(t, System().ColorEnabled())
+	})
+
+	t.Run("CLICOLOR_FORCE=0 doesn't force colors", func(t *testing.T) {
+		t.Setenv("CLICOLOR_FORCE", "0")
+		require.False(t, EnvColorForced())
+	})
+}
+
+func TestTerminalWidth(t *testing.T) {
+	s := &IOStreams{Out: &bytes.Buffer{}}
+	require.False(t, s.IsStdoutTTY())
+	require.Equal(t, 0, s.TerminalWidth())
+
+	s.SetStdoutTTY(true)
+	t.Setenv("COLUMNS", "120")
+	require.Equal(t, 120, s.TerminalWidth())
+
+	t.Setenv("COLUMNS", "")
+	require.Equal(t, DefaultWidth, s.TerminalWidth())
+
+	s.SetTerminalWidth(42)
+	require.Equal(t, 42, s.TerminalWidth())
+}
@@ -0,0 +1,158 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Limits of the log file: once it reaches MaxFileSize it is renamed to <path>.1, and so on up to
+// <path>.<MaxFileBackups>
+const (
+	MaxFileSize    = 5 << 20
+	MaxFileBackups = 3
+)
+
+var file *rotatingFile
+
+// AddFile makes every entry from the debug level on, whatever the level of the console, also go to
+// the file at path as JSON. Each entry has fields and the time elapsed since AddFile was called
+func AddFile(path string, fields ...zap.Field) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	f, err := openRotatingFile(path, MaxFileSize, MaxFileBackups)
+	if err != nil {
+		return err
+	}
+
+	encoderConfig := zap.NewProductionEncoderConfig()
+	encoderConfig.TimeKey = "timestamp"
+	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	core := elapsedCore{
+		Core:    zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), f, zapcore.DebugLevel).With(fields),
+		started: time.Now(),
+	}
+
+	_ = CloseFile()
+	file = f
+	log = log.WithOptions(zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+		return zapcore.NewTee(c, core)
+	}))
+	return nil
+}
+
+// CloseFile writes the last entry of the file of AddFile, with fields, and closes it
+func CloseFile(fields ...zap.Field) error {
+	if file == nil {
+		return nil
+	}
+	log.Debug("Command finished", fields...)
+	_ = log.Sync()
+
+	err := file.Close()
+	file = nil
+	return err
+}
+
+// elapsedCore adds the time elapsed since the command started to each entry
+type elapsedCore struct {
+	zapcore.Core
+	started time.Time
+}
+
+func (c elapsedCore) With(fields []zapcore.Field) zapcore.Core {
+	return elapsedCore{Core: c.Core.With(fields), started: c.started}
+}
+
+func (c elapsedCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c elapsedCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, append(fields, zap.Duration("elapsed", entry.Time.Sub(c.started))))
+}
+
+// rotatingFile appends to a file and rotates it once it reaches maxSize bytes, keeping backups old files
+type rotatingFile struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	size    int64
+	maxSize int64
+	backups int
+}
+
+func openRotatingFile(path string, maxSize int64, backups int) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, maxSize: maxSize, backups: backups}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.file, r.size = f, info.Size()
+	return nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		if err := r.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotate renames the file to <path>.1, after moving the older backups up and removing the oldest one
+func (r *rotatingFile) rotate() error {
+	if err := r.file.Close(); err != nil {
+		return err
+	}
+	for i := r.backups - 1; i > 0; i-- {
+		_ = os.Rename(fmt.Sprintf("%s.%d", r.path, i), fmt.Sprintf("%s.%d", r.path, i+1))
+	}
+	if r.backups > 0 {
+		if err := os.Rename(r.path, r.path+".1"); err != nil {
+			return err
+		}
+	} else if err := os.Remove(r.path); err != nil {
+		return err
+	}
+	return r.open()
+}
+
+func (r *rotatingFile) Sync() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Sync()
+}
+
+func (r *rotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
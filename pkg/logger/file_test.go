@@ -0,0 +1,70 @@
+package logger
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestAddFile(t *testing.T) {
+	New(zapcore.InfoLevel)
+	path := filepath.Join(t.TempDir(), "logs", "azion.log")
+
+	require.NoError(t, AddFile(path, zap.String("invocation_id", "abc")))
+	Debug("only in the file", zap.Int("id", 7))
+	require.NoError(t, CloseFile(zap.Int("exit_code", 0)))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []map[string]interface{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		entry := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &entry))
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 2)
+
+	require.Equal(t, "debug", entries[0]["level"])
+	require.Equal(t, "only in the file", entries[0]["msg"])
+	require.Equal(t, "abc", entries[0]["invocation_id"])
+	require.EqualValues(t, 7, entries[0]["id"])
+	require.Contains(t, entries[0], "elapsed")
+	require.Equal(t, "Command finished", entries[1]["msg"])
+	require.EqualValues(t, 0, entries[1]["exit_code"])
+
+	// the console keeps its level
+	require.False(t, consoleLevel.Enabled(zapcore.DebugLevel))
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "azion.log")
+	r, err := openRotatingFile(path, 10, 2)
+	require.NoError(t, err)
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := r.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	require.NoError(t, r.Close())
+
+	read := func(name string) string {
+		data, err := os.ReadFile(name)
+		require.NoError(t, err)
+		return strings.TrimSpace(string(data))
+	}
+	require.Equal(t, "fourth", read(path))
+	require.Equal(t, "third", read(path+".1"))
+	require.Equal(t, "second", read(path+".2"))
+	_, err = os.Stat(path + ".3")
+	require.True(t, os.IsNotExist(err))
+}
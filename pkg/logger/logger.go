@@ -13,6 +13,8 @@ import (
 
 var (
 	log *zap.Logger
+	// consoleLevel is the level of the console, which the file of AddFile doesn't change
+	consoleLevel zap.AtomicLevel
 )
 
 type Logger struct {
@@ -67,8 +69,9 @@ func New(level zapcore.Level) {
 	config.Encoding = "console"
 	config.OutputPaths = []string{"stdout"}
 	config.ErrorOutputPaths = []string{"stderr"}
-	config.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	config.EncoderConfig.EncodeLevel = encodeLevel
 
+	consoleLevel = logLevel
 	log, err = config.Build(zap.AddCallerSkip(1))
 
 	if err != nil {
@@ -76,24 +79,33 @@ func New(level zapcore.Level) {
 	}
 }
 
+// encodeLevel colors the level unless colors are disabled, which is only known once the flags are read
+func encodeLevel(level zapcore.Level, enc zapcore.PrimitiveArrayEncoder) {
+	if color.NoColor {
+		zapcore.CapitalLevelEncoder(level, enc)
+		return
+	}
+	zapcore.CapitalColorLevelEncoder(level, enc)
+}
+
 // FInfo I need to check if the debug is false because the error comes in the debug also as true
 func FInfo(w io.Writer, message string) {
-	if !(log.Core().Enabled(zapcore.ErrorLevel) && !log.Core().Enabled(zapcore.DebugLevel)) ||
-		!(log.Core().Enabled(zapcore.ErrorLevel) && !log.Core().Enabled(zapcore.InfoLevel)) {
+	if !(consoleLevel.Enabled(zapcore.ErrorLevel) && !consoleLevel.Enabled(zapcore.DebugLevel)) ||
+		!(consoleLevel.Enabled(zapcore.ErrorLevel) && !consoleLevel.Enabled(zapcore.InfoLevel)) {
 		fmt.Fprintf(w, message) // nolint:all
 	}
 }
 
 func PrintHeader(table table.Table, format string) {
-	if !(log.Core().Enabled(zapcore.ErrorLevel) && !log.Core().Enabled(zapcore.DebugLevel)) ||
-		!(log.Core().Enabled(zapcore.ErrorLevel) && !log.Core().Enabled(zapcore.InfoLevel)) {
+	if !(consoleLevel.Enabled(zapcore.ErrorLevel) && !consoleLevel.Enabled(zapcore.DebugLevel)) ||
+		!(consoleLevel.Enabled(zapcore.ErrorLevel) && !consoleLevel.Enabled(zapcore.InfoLevel)) {
 		table.PrintHeader(format)
 	}
 }
 
 func PrintRow(table table.Table, format string, row []string) {
-	if !(log.Core().Enabled(zapcore.ErrorLevel) && !log.Core().Enabled(zapcore.DebugLevel)) ||
-		!(log.Core().Enabled(zapcore.ErrorLevel) && !log.Core().Enabled(zapcore.InfoLevel)) {
+	if !(consoleLevel.Enabled(zapcore.ErrorLevel) && !consoleLevel.Enabled(zapcore.DebugLevel)) ||
+		!(consoleLevel.Enabled(zapcore.ErrorLevel) && !consoleLevel.Enabled(zapcore.InfoLevel)) {
 		table.PrintRow(format, row)
 	}
 }
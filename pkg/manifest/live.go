@@ -0,0 +1,364 @@
+package manifest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	msg "github.com/aziontech/azion-cli/messages/manifest"
+	"github.com/aziontech/azion-cli/pkg/api/domains"
+	edgeApplications "github.com/aziontech/azion-cli/pkg/api/edge_applications"
+	edgeFunctions "github.com/aziontech/azion-cli/pkg/api/edge_functions"
+	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/utils"
+	sdk "github.com/aziontech/azionapi-go-sdk/edgeapplications"
+	"go.uber.org/zap"
+)
+
+// pageSize is the number of resources each list request returns
+const pageSize = 100
+
+// Clients are the API clients that read and change the resources of a manifest
+type Clients struct {
+	Applications *edgeApplications.Client
+	Domains      *domains.Client
+	Functions    *edgeFunctions.Client
+
+	functionNames map[int64]string
+}
+
+func NewClients(c *http.Client, url string, token string) *Clients {
+	return &Clients{
+		Applications: edgeApplications.NewClient(c, url, token),
+		Domains:      domains.NewClient(c, url, token),
+		Functions:    edgeFunctions.NewClient(c, url, token),
+	}
+}
+
+// FindApplication returns the ID of the edge application of a manifest: its recorded ID or,
+// when it has none, the ID of the edge application with its name. It returns 0 when the account
+// doesn't have it
+func (c *Clients) FindApplication(ctx context.Context, app *Application) (int64, error) {
+	if app.ID != 0 {
+		return app.ID, nil
+	}
+
+	var found int64
+	err := eachPage(func(opts *contracts.ListOptions) (int64, error) {
+		resp, err := c.Applications.List(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+		for _, a := range resp.Results {
+			if a.Name == app.Name && found == 0 {
+				found = a.Id
+			}
+		}
+		return resp.TotalPages, nil
+	})
+	return found, err
+}
+
+// EdgeFunctionNames returns the names of the edge functions of the account by ID
+func (c *Clients) EdgeFunctionNames(ctx context.Context) (map[int64]string, error) {
+	if c.functionNames != nil {
+		return c.functionNames, nil
+	}
+
+	names := map[int64]string{}
+	err := eachPage(func(opts *contracts.ListOptions) (int64, error) {
+		functions, totalPages, err := c.Functions.List(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+		for _, fn := range functions {
+			names[fn.GetId()] = fn.GetName()
+		}
+		return totalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	c.functionNames = names
+	return names, nil
+}
+
+// Fetch reads an edge application and its resources as a manifest, with the names of the
+// resources in the references between them
+func (c *Clients) Fetch(ctx context.Context, appID int64) (*Manifest, error) {
+	logger.Debug("Fetching the resources of the edge application", zap.Int64("id", appID))
+	m, err := c.fetch(ctx, appID)
+	if err != nil {
+		return nil, utils.WrapError(msg.ErrorFetchResources, err)
+	}
+	return m, nil
+}
+
+func (c *Clients) fetch(ctx context.Context, appID int64) (*Manifest, error) {
+	resp, err := c.Applications.Get(ctx, strconv.FormatInt(appID, 10))
+	if err != nil {
+		if utils.AsError(err).Code == utils.CodeNotFound {
+			return nil, utils.NewError(utils.CodeNotFound, fmt.Errorf(msg.ErrorApplicationNotFound.Error(), appID))
+		}
+		return nil, err
+	}
+	app := Application{
+		ID:                      resp.GetId(),
+		Name:                    resp.GetName(),
+		DeliveryProtocol:        resp.GetDeliveryProtocol(),
+		MinimumTlsVersion:       resp.GetMinimumTlsVersion(),
+		Active:                  boolPtr(resp.GetActive()),
+		ApplicationAcceleration: boolPtr(resp.GetApplicationAcceleration()),
+		Caching:                 boolPtr(resp.GetCaching()),
+		DeviceDetection:         boolPtr(resp.GetDeviceDetection()),
+		EdgeFirewall:            boolPtr(resp.GetEdgeFirewall()),
+		EdgeFunctions:           boolPtr(resp.GetEdgeFunctions()),
+		ImageOptimization:       boolPtr(resp.GetImageOptimization()),
+		L2Caching:               boolPtr(resp.GetL2Caching()),
+		LoadBalancer:            boolPtr(resp.GetLoadBalancer()),
+		RawLogs:                 boolPtr(resp.GetRawLogs()),
+		WebApplicationFirewall:  boolPtr(resp.GetWebApplicationFirewall()),
+	}
+
+	origins, err := c.Applications.ListOrigins(ctx, &contracts.ListOptions{}, appID)
+	if err != nil {
+		return nil, err
+	}
+	originNames := map[string]string{}
+	for _, o := range origins.Results {
+		addresses := make([]string, 0, len(o.Addresses))
+		for _, a := range o.Addresses {
+			addresses = append(addresses, a.Address)
+		}
+		app.Origins = append(app.Origins, Origin{
+			ID:                   o.OriginId,
+			Key:                  o.OriginKey,
+			Name:                 o.Name,
+			OriginType:           o.OriginType,
+			Addresses:            addresses,
+			OriginProtocolPolicy: o.OriginProtocolPolicy,
+			HostHeader:           o.HostHeader,
+			OriginPath:           o.OriginPath,
+			HmacAuthentication:   boolPtr(o.HmacAuthentication),
+			HmacRegionName:       o.HmacRegionName,
+			HmacAccessKey:        o.HmacAccessKey,
+			HmacSecretKey:        o.HmacSecretKey,
+		})
+		originNames[strconv.FormatInt(o.OriginId, 10)] = o.Name
+	}
+
+	cacheNames := map[string]string{}
+	err = eachPage(func(opts *contracts.ListOptions) (int64, error) {
+		resp, err := c.Applications.ListCacheSettings(ctx, opts, appID)
+		if err != nil {
+			return 0, err
+		}
+		for _, cs := range resp.Results {
+			app.CacheSettings = append(app.CacheSettings, cacheSetting(cs))
+			cacheNames[strconv.FormatInt(cs.Id, 10)] = cs.Name
+		}
+		return resp.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = eachPage(func(opts *contracts.ListOptions) (int64, error) {
+		resp, err := c.Applications.DeviceGroupsList(ctx, opts, appID)
+		if err != nil {
+			return 0, err
+		}
+		for _, g := range resp.Results {
+			app.DeviceGroups = append(app.DeviceGroups, DeviceGroup{ID: g.GetId(), Name: g.Name, UserAgent: g.UserAgent})
+		}
+		return resp.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	instanceNames := map[string]string{}
+	var instances []sdk.ApplicationInstancesResults
+	err = eachPage(func(opts *contracts.ListOptions) (int64, error) {
+		resp, err := c.Applications.EdgeFuncInstancesList(ctx, opts, appID)
+		if err != nil {
+			return 0, err
+		}
+		instances = append(instances, resp.Results...)
+		return resp.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(instances) > 0 {
+		functionNames, err := c.EdgeFunctionNames(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, fi := range instances {
+			function, ok := functionNames[fi.EdgeFunctionId]
+			if !ok {
+				function = strconv.FormatInt(fi.EdgeFunctionId, 10)
+			}
+			args, _ := fi.Args.(map[string]interface{})
+			app.FunctionInstances = append(app.FunctionInstances, FunctionInstance{
+				ID:           fi.Id,
+				Name:         fi.Name,
+				EdgeFunction: function,
+				Args:         args,
+			})
+			instanceNames[strconv.FormatInt(fi.Id, 10)] = fi.Name
+		}
+	}
+
+	targets := map[string]map[string]string{
+		BehaviorSetOrigin:      originNames,
+		BehaviorSetCachePolicy: cacheNames,
+		BehaviorRunFunction:    instanceNames,
+	}
+	for _, phase := range Phases {
+		var results []sdk.RulesEngineResultResponse
+		err = eachPage(func(opts *contracts.ListOptions) (int64, error) {
+			resp, err := c.Applications.ListRulesEngine(ctx, opts, appID, phase)
+			if err != nil {
+				return 0, err
+			}
+			results = append(results, resp.Results...)
+			return resp.TotalPages, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.SliceStable(results, func(i, j int) bool { return results[i].Order < results[j].Order })
+
+		var rules []Rule
+		for _, r := range results {
+			rules = append(rules, rule(r, phase, targets))
+		}
+		app.RulesEngine.SetRules(phase, rules)
+	}
+
+	err = eachPage(func(opts *contracts.ListOptions) (int64, error) {
+		resp, err := c.Domains.List(ctx, opts)
+		if err != nil {
+			return 0, err
+		}
+		for _, d := range resp.Results {
+			if d.EdgeApplicationId != appID {
+				continue
+			}
+			app.Domains = append(app.Domains, Domain{
+				ID:                   d.Id,
+				Name:                 d.Name,
+				Cnames:               d.Cnames,
+				CnameAccessOnly:      boolPtr(d.CnameAccessOnly),
+				IsActive:             boolPtr(d.IsActive),
+				DigitalCertificateID: d.DigitalCertificateId.Get(),
+			})
+		}
+		return resp.TotalPages, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Manifest{EdgeApplication: app}, nil
+}
+
+func cacheSetting(cs sdk.ApplicationCacheResults) CacheSetting {
+	var cookieNames []string
+	for _, name := range cs.CookieNames {
+		if name != nil {
+			cookieNames = append(cookieNames, *name)
+		}
+	}
+	return CacheSetting{
+		ID:                             cs.Id,
+		Name:                           cs.Name,
+		BrowserCacheSettings:           cs.BrowserCacheSettings,
+		BrowserCacheSettingsMaximumTtl: int64Ptr(cs.BrowserCacheSettingsMaximumTtl),
+		CdnCacheSettings:               cs.CdnCacheSettings,
+		CdnCacheSettingsMaximumTtl:     int64Ptr(cs.CdnCacheSettingsMaximumTtl),
+		CacheByQueryString:             cs.CacheByQueryString,
+		QueryStringFields:              cs.QueryStringFields,
+		EnableQueryStringSort:          boolPtr(cs.EnableQueryStringSort),
+		CacheByCookies:                 cs.CacheByCookies,
+		CookieNames:                    cookieNames,
+		AdaptiveDeliveryAction:         cs.AdaptiveDeliveryAction,
+		EnableCachingForPost:           boolPtr(cs.EnableCachingForPost),
+		EnableCachingForOptions:        boolPtr(cs.EnableCachingForOptions),
+		L2CachingEnabled:               boolPtr(cs.L2CachingEnabled),
+	}
+}
+
+// rule converts a rule of the account, replacing the IDs its behaviors target with the names of
+// the resources
+func rule(r sdk.RulesEngineResultResponse, phase string, targets map[string]map[string]string) Rule {
+	var criteria [][]Criteria
+	for _, group := range r.Criteria {
+		var entries []Criteria
+		for _, c := range group {
+			entries = append(entries, Criteria{
+				Conditional: c.Conditional,
+				Variable:    c.Variable,
+				Operator:    c.Operator,
+				InputValue:  c.GetInputValue(),
+			})
+		}
+		criteria = append(criteria, entries)
+	}
+
+	var behaviors []Behavior
+	for _, entry := range r.Behaviors {
+		switch {
+		case entry.RulesEngineBehaviorString != nil:
+			b := entry.RulesEngineBehaviorString
+			target := b.Target
+			if name, ok := targets[b.Name][target]; ok {
+				target = name
+			}
+			behaviors = append(behaviors, Behavior{Name: b.Name, Target: target})
+		case entry.RulesEngineBehaviorObject != nil:
+			b := entry.RulesEngineBehaviorObject
+			behaviors = append(behaviors, Behavior{Name: b.Name, Capture: &Capture{
+				CapturedArray: b.Target.GetCapturedArray(),
+				Subject:       b.Target.GetSubject(),
+				Regex:         b.Target.GetRegex(),
+			}})
+		}
+	}
+
+	return Rule{
+		ID:          r.Id,
+		Name:        r.Name,
+		Description: r.GetDescription(),
+		Criteria:    criteria,
+		Behaviors:   behaviors,
+		Default:     phase == "request" && r.Order == 0,
+	}
+}
+
+// eachPage calls a list request for every page of the results. The request returns the number
+// of pages
+func eachPage(list func(opts *contracts.ListOptions) (int64, error)) error {
+	for page := int64(1); ; page++ {
+		totalPages, err := list(&contracts.ListOptions{Page: page, PageSize: pageSize})
+		if err != nil {
+			return err
+		}
+		if page >= totalPages {
+			return nil
+		}
+	}
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func int64Ptr(n int64) *int64 {
+	return &n
+}
@@ -0,0 +1,336 @@
+// Package manifest describes an edge application and the resources that belong to it in a
+// YAML or JSON file, and compares it with the resources of the account
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/manifest"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"go.uber.org/zap"
+	"gopkg.in/yaml.v2"
+)
+
+// Phases of the rules engine, in the order the rules run
+var Phases = []string{"request", "response"}
+
+// Behaviors whose target is the ID of another resource of the manifest. The manifest uses the
+// name of the resource instead
+const (
+	BehaviorSetOrigin      = "set_origin"
+	BehaviorSetCachePolicy = "set_cache_policy"
+	BehaviorRunFunction    = "run_function"
+)
+
+// Manifest is the content of a manifest file. Fields left out of it aren't managed, so the
+// values of the account are kept
+type Manifest struct {
+	EdgeApplication Application `json:"edge_application" yaml:"edge_application"`
+}
+
+type Application struct {
+	ID                      int64              `json:"id,omitempty" yaml:"id,omitempty"`
+	Name                    string             `json:"name" yaml:"name"`
+	DeliveryProtocol        string             `json:"delivery_protocol,omitempty" yaml:"delivery_protocol,omitempty"`
+	MinimumTlsVersion       string             `json:"minimum_tls_version,omitempty" yaml:"minimum_tls_version,omitempty"`
+	Active                  *bool              `json:"active,omitempty" yaml:"active,omitempty"`
+	ApplicationAcceleration *bool              `json:"application_acceleration,omitempty" yaml:"application_acceleration,omitempty"`
+	Caching                 *bool              `json:"caching,omitempty" yaml:"caching,omitempty"`
+	DeviceDetection         *bool              `json:"device_detection,omitempty" yaml:"device_detection,omitempty"`
+	EdgeFirewall            *bool              `json:"edge_firewall,omitempty" yaml:"edge_firewall,omitempty"`
+	EdgeFunctions           *bool              `json:"edge_functions,omitempty" yaml:"edge_functions,omitempty"`
+	ImageOptimization       *bool              `json:"image_optimization,omitempty" yaml:"image_optimization,omitempty"`
+	L2Caching               *bool              `json:"l2_caching,omitempty" yaml:"l2_caching,omitempty"`
+	LoadBalancer            *bool              `json:"load_balancer,omitempty" yaml:"load_balancer,omitempty"`
+	RawLogs                 *bool              `json:"raw_logs,omitempty" yaml:"raw_logs,omitempty"`
+	WebApplicationFirewall  *bool              `json:"web_application_firewall,omitempty" yaml:"web_application_firewall,omitempty"`
+	Origins                 []Origin           `json:"origins,omitempty" yaml:"origins,omitempty"`
+	CacheSettings           []CacheSetting     `json:"cache_settings,omitempty" yaml:"cache_settings,omitempty"`
+	DeviceGroups            []DeviceGroup      `json:"device_groups,omitempty" yaml:"device_groups,omitempty"`
+	FunctionInstances       []FunctionInstance `json:"function_instances,omitempty" yaml:"function_instances,omitempty"`
+	RulesEngine             RulesEngine        `json:"rules_engine,omitempty" yaml:"rules_engine,omitempty"`
+	Domains                 []Domain           `json:"domains,omitempty" yaml:"domains,omitempty"`
+}
+
+type Origin struct {
+	ID int64 `json:"id,omitempty" yaml:"id,omitempty"`
+	// Key is the origin key the API uses to update and delete the origin
+	Key                  string   `json:"key,omitempty" yaml:"key,omitempty"`
+	Name                 string   `json:"name" yaml:"name"`
+	OriginType           string   `json:"origin_type,omitempty" yaml:"origin_type,omitempty"`
+	Addresses            []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	OriginProtocolPolicy string   `json:"origin_protocol_policy,omitempty" yaml:"origin_protocol_policy,omitempty"`
+	HostHeader           string   `json:"host_header,omitempty" yaml:"host_header,omitempty"`
+	OriginPath           string   `json:"origin_path,omitempty" yaml:"origin_path,omitempty"`
+	HmacAuthentication   *bool    `json:"hmac_authentication,omitempty" yaml:"hmac_authentication,omitempty"`
+	HmacRegionName       string   `json:"hmac_region_name,omitempty" yaml:"hmac_region_name,omitempty"`
+	HmacAccessKey        string   `json:"hmac_access_key,omitempty" yaml:"hmac_access_key,omitempty"`
+	HmacSecretKey        string   `json:"hmac_secret_key,omitempty" yaml:"hmac_secret_key,omitempty"`
+}
+
+type CacheSetting struct {
+	ID                             int64    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name                           string   `json:"name" yaml:"name"`
+	BrowserCacheSettings           string   `json:"browser_cache_settings,omitempty" yaml:"browser_cache_settings,omitempty"`
+	BrowserCacheSettingsMaximumTtl *int64   `json:"browser_cache_settings_maximum_ttl,omitempty" yaml:"browser_cache_settings_maximum_ttl,omitempty"`
+	CdnCacheSettings               string   `json:"cdn_cache_settings,omitempty" yaml:"cdn_cache_settings,omitempty"`
+	CdnCacheSettingsMaximumTtl     *int64   `json:"cdn_cache_settings_maximum_ttl,omitempty" yaml:"cdn_cache_settings_maximum_ttl,omitempty"`
+	CacheByQueryString             string   `json:"cache_by_query_string,omitempty" yaml:"cache_by_query_string,omitempty"`
+	QueryStringFields              []string `json:"query_string_fields,omitempty" yaml:"query_string_fields,omitempty"`
+	EnableQueryStringSort          *bool    `json:"enable_query_string_sort,omitempty" yaml:"enable_query_string_sort,omitempty"`
+	CacheByCookies                 string   `json:"cache_by_cookies,omitempty" yaml:"cache_by_cookies,omitempty"`
+	CookieNames                    []string `json:"cookie_names,omitempty" yaml:"cookie_names,omitempty"`
+	AdaptiveDeliveryAction         string   `json:"adaptive_delivery_action,omitempty" yaml:"adaptive_delivery_action,omitempty"`
+	EnableCachingForPost           *bool    `json:"enable_caching_for_post,omitempty" yaml:"enable_caching_for_post,omitempty"`
+	EnableCachingForOptions        *bool    `json:"enable_caching_for_options,omitempty" yaml:"enable_caching_for_options,omitempty"`
+	L2CachingEnabled               *bool    `json:"l2_caching_enabled,omitempty" yaml:"l2_caching_enabled,omitempty"`
+}
+
+type DeviceGroup struct {
+	ID        int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Name      string `json:"name" yaml:"name"`
+	UserAgent string `json:"user_agent,omitempty" yaml:"user_agent,omitempty"`
+}
+
+type FunctionInstance struct {
+	ID   int64  `json:"id,omitempty" yaml:"id,omitempty"`
+	Name string `json:"name" yaml:"name"`
+	// EdgeFunction is the name or the ID of the edge function the instance runs
+	EdgeFunction string                 `json:"edge_function,omitempty" yaml:"edge_function,omitempty"`
+	Args         map[string]interface{} `json:"args,omitempty" yaml:"args,omitempty"`
+}
+
+type RulesEngine struct {
+	Request  []Rule `json:"request,omitempty" yaml:"request,omitempty"`
+	Response []Rule `json:"response,omitempty" yaml:"response,omitempty"`
+}
+
+type Rule struct {
+	ID          int64        `json:"id,omitempty" yaml:"id,omitempty"`
+	Name        string       `json:"name" yaml:"name"`
+	Description string       `json:"description,omitempty" yaml:"description,omitempty"`
+	Criteria    [][]Criteria `json:"criteria,omitempty" yaml:"criteria,omitempty"`
+	Behaviors   []Behavior   `json:"behaviors,omitempty" yaml:"behaviors,omitempty"`
+	// Default marks the first rule of the request phase, which the API doesn't let you delete
+	Default bool `json:"-" yaml:"-"`
+}
+
+type Criteria struct {
+	Conditional string `json:"conditional" yaml:"conditional"`
+	Variable    string `json:"variable" yaml:"variable"`
+	Operator    string `json:"operator" yaml:"operator"`
+	InputValue  string `json:"input_value,omitempty" yaml:"input_value,omitempty"`
+}
+
+type Behavior struct {
+	Name string `json:"name" yaml:"name"`
+	// Target is the name of the origin, cache setting or function instance of set_origin,
+	// set_cache_policy and run_function, and the value of the other behaviors
+	Target  string   `json:"target,omitempty" yaml:"target,omitempty"`
+	Capture *Capture `json:"capture,omitempty" yaml:"capture,omitempty"`
+}
+
+// Capture is the target of the capture_match_groups behavior
+type Capture struct {
+	CapturedArray string `json:"captured_array,omitempty" yaml:"captured_array,omitempty"`
+	Subject       string `json:"subject,omitempty" yaml:"subject,omitempty"`
+	Regex         string `json:"regex,omitempty" yaml:"regex,omitempty"`
+}
+
+type Domain struct {
+	ID                   int64    `json:"id,omitempty" yaml:"id,omitempty"`
+	Name                 string   `json:"name" yaml:"name"`
+	Cnames               []string `json:"cnames,omitempty" yaml:"cnames,omitempty"`
+	CnameAccessOnly      *bool    `json:"cname_access_only,omitempty" yaml:"cname_access_only,omitempty"`
+	IsActive             *bool    `json:"is_active,omitempty" yaml:"is_active,omitempty"`
+	DigitalCertificateID *int64   `json:"digital_certificate_id,omitempty" yaml:"digital_certificate_id,omitempty"`
+}
+
+// Rules returns the rules of a phase of the rules engine
+func (r *RulesEngine) Rules(phase string) []Rule {
+	if phase == "response" {
+		return r.Response
+	}
+	return r.Request
+}
+
+// SetRules replaces the rules of a phase of the rules engine
+func (r *RulesEngine) SetRules(phase string, rules []Rule) {
+	if phase == "response" {
+		r.Response = rules
+		return
+	}
+	r.Request = rules
+}
+
+// IsJSON reports whether a manifest file is read and written as JSON instead of YAML
+func IsJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+// Read loads a manifest file, as JSON when its extension is .json and as YAML otherwise
+func Read(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		logger.Debug("Error while reading the manifest file", zap.Error(err))
+		return nil, fmt.Errorf(msg.ErrorReadManifest.Error(), path, err)
+	}
+
+	m, err := Parse(data, IsJSON(path))
+	if err != nil {
+		return nil, fmt.Errorf(msg.ErrorParseManifest.Error(), path, err)
+	}
+	return m, nil
+}
+
+// Parse decodes the content of a manifest file and checks that its resources can be told apart
+func Parse(data []byte, isJSON bool) (*Manifest, error) {
+	m := &Manifest{}
+	var err error
+	if isJSON {
+		err = json.Unmarshal(data, m)
+	} else {
+		err = yaml.UnmarshalStrict(data, m)
+	}
+	if err != nil {
+		logger.Debug("Error while parsing the manifest", zap.Error(err))
+		return nil, err
+	}
+
+	for i := range m.EdgeApplication.FunctionInstances {
+		args := m.EdgeApplication.FunctionInstances[i].Args
+		for k, v := range args {
+			args[k] = normalize(v)
+		}
+	}
+	return m, m.Validate()
+}
+
+// Validate checks that every resource has a name that is unique among the resources of its kind,
+// since names are what matches them with the resources of the account
+func (m *Manifest) Validate() error {
+	app := &m.EdgeApplication
+	if app.Name == "" {
+		return fmt.Errorf(msg.ErrorMissingName.Error(), KindApplication)
+	}
+
+	names := map[string][]string{}
+	for _, o := range app.Origins {
+		names[KindOrigin] = append(names[KindOrigin], o.Name)
+	}
+	for _, c := range app.CacheSettings {
+		names[KindCacheSetting] = append(names[KindCacheSetting], c.Name)
+	}
+	for _, d := range app.DeviceGroups {
+		names[KindDeviceGroup] = append(names[KindDeviceGroup], d.Name)
+	}
+	for _, fi := range app.FunctionInstances {
+		names[KindFunctionInstance] = append(names[KindFunctionInstance], fi.Name)
+	}
+	for _, phase := range Phases {
+		for _, r := range app.RulesEngine.Rules(phase) {
+			names[phase+" "+KindRule] = append(names[phase+" "+KindRule], r.Name)
+		}
+	}
+	for _, d := range app.Domains {
+		names[KindDomain] = append(names[KindDomain], d.Name)
+	}
+
+	for kind, list := range names {
+		seen := map[string]bool{}
+		for _, name := range list {
+			if name == "" {
+				return fmt.Errorf(msg.ErrorMissingName.Error(), kind)
+			}
+			if seen[name] {
+				return fmt.Errorf(msg.ErrorDuplicatedName.Error(), kind, name)
+			}
+			seen[name] = true
+		}
+	}
+	return nil
+}
+
+// Strip removes the IDs and keys the account gave to the resources, so the manifest matches them
+// by name only. It also removes the HMAC keys of the origins, which are secrets that don't belong
+// in version control; apply keeps the keys of the account when the manifest leaves them out
+func (m *Manifest) Strip() {
+	app := &m.EdgeApplication
+	app.ID = 0
+	for i := range app.Origins {
+		app.Origins[i].ID = 0
+		app.Origins[i].Key = ""
+		app.Origins[i].HmacAccessKey = ""
+		app.Origins[i].HmacSecretKey = ""
+	}
+	for i := range app.CacheSettings {
+		app.CacheSettings[i].ID = 0
+	}
+	for i := range app.DeviceGroups {
+		app.DeviceGroups[i].ID = 0
+	}
+	for i := range app.FunctionInstances {
+		app.FunctionInstances[i].ID = 0
+	}
+	for _, phase := range Phases {
+		rules := app.RulesEngine.Rules(phase)
+		for i := range rules {
+			rules[i].ID = 0
+		}
+	}
+	for i := range app.Domains {
+		app.Domains[i].ID = 0
+	}
+}
+
+// Marshal encodes a manifest as JSON or YAML
+func (m *Manifest) Marshal(isJSON bool) ([]byte, error) {
+	if isJSON {
+		data, err := json.MarshalIndent(m, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	}
+	return yaml.Marshal(m)
+}
+
+// Write saves a manifest file, as JSON when its extension is .json and as YAML otherwise
+func (m *Manifest) Write(path string) error {
+	data, err := m.Marshal(IsJSON(path))
+	if err == nil {
+		err = os.WriteFile(path, data, 0644)
+	}
+	if err != nil {
+		logger.Debug("Error while writing the manifest file", zap.Error(err))
+		return fmt.Errorf(msg.ErrorWriteManifest.Error(), path, err)
+	}
+	return nil
+}
+
+// normalize converts the maps YAML decodes into maps with string keys, which JSON can encode
+func normalize(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			m[fmt.Sprint(k)] = normalize(item)
+		}
+		return m
+	case map[string]interface{}:
+		for k, item := range value {
+			value[k] = normalize(item)
+		}
+		return value
+	case []interface{}:
+		for i, item := range value {
+			value[i] = normalize(item)
+		}
+		return value
+	}
+	return v
+}
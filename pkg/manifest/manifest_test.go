@@ -0,0 +1,120 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+const stack = `
+edge_application:
+  name: my-app
+  caching: true
+  origins:
+    - name: api
+      addresses: [api.example.com]
+  function_instances:
+    - name: auth
+      edge_function: auth-function
+      args:
+        limits:
+          rate: 10
+  rules_engine:
+    request:
+      - name: Default Rule
+        behaviors:
+          - name: set_origin
+            target: api
+`
+
+func TestParse(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+
+	t.Run("yaml", func(t *testing.T) {
+		m, err := Parse([]byte(stack), false)
+		require.NoError(t, err)
+
+		app := m.EdgeApplication
+		require.Equal(t, "my-app", app.Name)
+		require.True(t, *app.Caching)
+		require.Nil(t, app.Active)
+		require.Equal(t, []string{"api.example.com"}, app.Origins[0].Addresses)
+		require.Equal(t, map[string]interface{}{"rate": 10}, app.FunctionInstances[0].Args["limits"])
+		require.Equal(t, "api", app.RulesEngine.Request[0].Behaviors[0].Target)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		m, err := Parse([]byte(`{"edge_application": {"id": 12, "name": "my-app", "domains": [{"name": "www"}]}}`), true)
+		require.NoError(t, err)
+		require.Equal(t, int64(12), m.EdgeApplication.ID)
+		require.Equal(t, "www", m.EdgeApplication.Domains[0].Name)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := Parse([]byte("edge_application:\n  name: my-app\n  colour: blue\n"), false)
+		require.Error(t, err)
+	})
+
+	t.Run("missing name", func(t *testing.T) {
+		_, err := Parse([]byte("edge_application:\n  name: my-app\n  origins:\n    - addresses: [a.com]\n"), false)
+		require.EqualError(t, err, "The manifest has an unnamed origin. Add a name to every resource of the manifest and try again")
+	})
+
+	t.Run("duplicated name", func(t *testing.T) {
+		_, err := Parse([]byte("edge_application:\n  name: my-app\n  rules_engine:\n    response:\n      - name: a\n      - name: a\n"), false)
+		require.EqualError(t, err, `The manifest has more than one response rule named "a". Use a unique name for every resource of the same kind and try again`)
+	})
+}
+
+func TestWrite(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	m, err := Parse([]byte(stack), false)
+	require.NoError(t, err)
+	m.EdgeApplication.ID = 1234
+
+	path := filepath.Join(t.TempDir(), "stack.yaml")
+	require.NoError(t, m.Write(path))
+	written, err := Read(path)
+	require.NoError(t, err)
+	require.Equal(t, m, written)
+
+	path = filepath.Join(t.TempDir(), "stack.json")
+	require.NoError(t, m.Write(path))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "\"edge_application\": {\n    \"id\": 1234,\n    \"name\": \"my-app\"")
+	written, err = Read(path)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"rate": float64(10)}, written.EdgeApplication.FunctionInstances[0].Args["limits"])
+
+	_, err = Read(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.ErrorContains(t, err, "Failed to read the manifest file")
+}
+
+func TestStrip(t *testing.T) {
+	m := &Manifest{EdgeApplication: Application{
+		ID:      1234,
+		Name:    "my-app",
+		Origins: []Origin{{ID: 1, Key: "k1", Name: "api", HmacAuthentication: boolPtr(true), HmacRegionName: "us-east-1", HmacAccessKey: "AKIA", HmacSecretKey: "secret"}},
+		RulesEngine: RulesEngine{
+			Request:  []Rule{{ID: 20, Name: "Default Rule", Default: true}},
+			Response: []Rule{{ID: 30, Name: "headers"}},
+		},
+		Domains: []Domain{{ID: 5, Name: "www"}},
+	}}
+
+	m.Strip()
+	require.Equal(t, &Manifest{EdgeApplication: Application{
+		Name:    "my-app",
+		Origins: []Origin{{Name: "api", HmacAuthentication: boolPtr(true), HmacRegionName: "us-east-1"}},
+		RulesEngine: RulesEngine{
+			Request:  []Rule{{Name: "Default Rule", Default: true}},
+			Response: []Rule{{Name: "headers"}},
+		},
+		Domains: []Domain{{Name: "www"}},
+	}}, m)
+}
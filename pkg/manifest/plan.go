@@ -0,0 +1,404 @@
+package manifest
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Kinds of the resources of a manifest
+const (
+	KindApplication      = "edge application"
+	KindOrigin           = "origin"
+	KindCacheSetting     = "cache setting"
+	KindDeviceGroup      = "device group"
+	KindFunctionInstance = "function instance"
+	KindRule             = "rule"
+	KindDomain           = "domain"
+)
+
+type Action string
+
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// FieldChange is a field whose value in the manifest differs from its value in the account
+type FieldChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+// Change is a resource to create, update or delete so the account matches the manifest
+type Change struct {
+	Action Action        `json:"action"`
+	Kind   string        `json:"kind"`
+	Phase  string        `json:"phase,omitempty"`
+	Name   string        `json:"name"`
+	ID     string        `json:"id,omitempty"`
+	Fields []FieldChange `json:"fields,omitempty"`
+	// Desired points to the resource of the manifest and Live to the one of the account
+	Desired interface{} `json:"-"`
+	Live    interface{} `json:"-"`
+}
+
+// Plan lists the changes in the order they can be made: creations and updates first, from the
+// resources others refer to, then deletions, from the resources that refer to others
+type Plan struct {
+	Changes []Change
+}
+
+// Empty reports whether the account already matches the manifest
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Count returns how many resources the plan creates, updates and deletes
+func (p *Plan) Count() (create, update, delete int) {
+	for _, c := range p.Changes {
+		switch c.Action {
+		case ActionCreate:
+			create++
+		case ActionUpdate:
+			update++
+		case ActionDelete:
+			delete++
+		}
+	}
+	return create, update, delete
+}
+
+// fields of an application that are resources of their own and not settings
+var nestedFields = map[string]bool{
+	"id":                 true,
+	"key":                true,
+	"origins":            true,
+	"cache_settings":     true,
+	"device_groups":      true,
+	"function_instances": true,
+	"rules_engine":       true,
+	"domains":            true,
+}
+
+// fields whose values are secrets, which changes display masked
+var secretFields = map[string]bool{
+	"hmac_access_key": true,
+	"hmac_secret_key": true,
+}
+
+const maskedValue = "(sensitive)"
+
+// Compare returns the fields a resource of the manifest sets to a value different from the one
+// of the same resource in the account. Fields the manifest leaves out aren't compared
+func Compare(desired, live interface{}) []FieldChange {
+	want, have := toMap(desired), toMap(live)
+
+	var changes []FieldChange
+	for _, field := range jsonFields(reflect.TypeOf(desired)) {
+		if nestedFields[field] {
+			continue
+		}
+		value, ok := want[field]
+		if !ok {
+			continue
+		}
+		if reflect.DeepEqual(value, have[field]) {
+			continue
+		}
+		if secretFields[field] {
+			changes = append(changes, FieldChange{Field: field, Old: maskedValue, New: maskedValue})
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field, Old: have[field], New: value})
+	}
+	return changes
+}
+
+// NewPlan compares a manifest with the state of its edge application in the account and records
+// in the manifest the IDs of the resources of the account its resources match. A nil live
+// manifest means the edge application doesn't exist yet
+func NewPlan(desired, live *Manifest) *Plan {
+	p := &Plan{}
+	want := &desired.EdgeApplication
+	if live == nil {
+		live = &Manifest{}
+		p.add(ActionCreate, KindApplication, "", want.Name, "", want, nil, nil)
+	} else {
+		want.ID = live.EdgeApplication.ID
+		if fields := Compare(want, &live.EdgeApplication); len(fields) > 0 {
+			p.add(ActionUpdate, KindApplication, "", want.Name, id(want.ID), want, &live.EdgeApplication, fields)
+		}
+	}
+	have := &live.EdgeApplication
+
+	var deletes []func()
+
+	// device groups
+	matched, unmatched := match(deviceGroupRefs(want.DeviceGroups), deviceGroupRefs(have.DeviceGroups))
+	for i := range want.DeviceGroups {
+		p.pair(KindDeviceGroup, "", matched[i], &want.DeviceGroups[i], func(j int) (interface{}, string) {
+			want.DeviceGroups[i].ID = have.DeviceGroups[j].ID
+			return &have.DeviceGroups[j], id(have.DeviceGroups[j].ID)
+		})
+	}
+	deleteDeviceGroups := unmatched
+	deletes = append(deletes, func() {
+		for _, j := range deleteDeviceGroups {
+			g := &have.DeviceGroups[j]
+			p.add(ActionDelete, KindDeviceGroup, "", g.Name, id(g.ID), nil, g, nil)
+		}
+	})
+
+	// origins
+	matched, unmatched = match(originRefs(want.Origins), originRefs(have.Origins))
+	for i := range want.Origins {
+		p.pair(KindOrigin, "", matched[i], &want.Origins[i], func(j int) (interface{}, string) {
+			want.Origins[i].ID, want.Origins[i].Key = have.Origins[j].ID, have.Origins[j].Key
+			return &have.Origins[j], have.Origins[j].Key
+		})
+	}
+	deleteOrigins := unmatched
+	deletes = append(deletes, func() {
+		for _, j := range deleteOrigins {
+			o := &have.Origins[j]
+			p.add(ActionDelete, KindOrigin, "", o.Name, o.Key, nil, o, nil)
+		}
+	})
+
+	// cache settings
+	matched, unmatched = match(cacheSettingRefs(want.CacheSettings), cacheSettingRefs(have.CacheSettings))
+	for i := range want.CacheSettings {
+		p.pair(KindCacheSetting, "", matched[i], &want.CacheSettings[i], func(j int) (interface{}, string) {
+			want.CacheSettings[i].ID = have.CacheSettings[j].ID
+			return &have.CacheSettings[j], id(have.CacheSettings[j].ID)
+		})
+	}
+	deleteCacheSettings := unmatched
+	deletes = append(deletes, func() {
+		for _, j := range deleteCacheSettings {
+			c := &have.CacheSettings[j]
+			p.add(ActionDelete, KindCacheSetting, "", c.Name, id(c.ID), nil, c, nil)
+		}
+	})
+
+	// function instances
+	matched, unmatched = match(functionInstanceRefs(want.FunctionInstances), functionInstanceRefs(have.FunctionInstances))
+	for i := range want.FunctionInstances {
+		p.pair(KindFunctionInstance, "", matched[i], &want.FunctionInstances[i], func(j int) (interface{}, string) {
+			want.FunctionInstances[i].ID = have.FunctionInstances[j].ID
+			return &have.FunctionInstances[j], id(have.FunctionInstances[j].ID)
+		})
+	}
+	deleteFunctionInstances := unmatched
+	deletes = append(deletes, func() {
+		for _, j := range deleteFunctionInstances {
+			fi := &have.FunctionInstances[j]
+			p.add(ActionDelete, KindFunctionInstance, "", fi.Name, id(fi.ID), nil, fi, nil)
+		}
+	})
+
+	// rules of each phase
+	for _, phase := range Phases {
+		phase := phase
+		wantRules, haveRules := want.RulesEngine.Rules(phase), have.RulesEngine.Rules(phase)
+		matched, unmatched = match(ruleRefs(wantRules), ruleRefs(haveRules))
+		for i := range wantRules {
+			p.pair(KindRule, phase, matched[i], &wantRules[i], func(j int) (interface{}, string) {
+				wantRules[i].ID = haveRules[j].ID
+				return &haveRules[j], id(haveRules[j].ID)
+			})
+		}
+		deleteRules := unmatched
+		deletes = append(deletes, func() {
+			for _, j := range deleteRules {
+				r := &haveRules[j]
+				// the default rule of the request phase can't be deleted
+				if r.Default {
+					continue
+				}
+				p.add(ActionDelete, KindRule, phase, r.Name, id(r.ID), nil, r, nil)
+			}
+		})
+	}
+
+	// domains
+	matched, unmatched = match(domainRefs(want.Domains), domainRefs(have.Domains))
+	for i := range want.Domains {
+		p.pair(KindDomain, "", matched[i], &want.Domains[i], func(j int) (interface{}, string) {
+			want.Domains[i].ID = have.Domains[j].ID
+			return &have.Domains[j], id(have.Domains[j].ID)
+		})
+	}
+	deleteDomains := unmatched
+	deletes = append(deletes, func() {
+		for _, j := range deleteDomains {
+			d := &have.Domains[j]
+			p.add(ActionDelete, KindDomain, "", d.Name, id(d.ID), nil, d, nil)
+		}
+	})
+
+	for i := len(deletes) - 1; i >= 0; i-- {
+		deletes[i]()
+	}
+	return p
+}
+
+func (p *Plan) add(action Action, kind, phase, name, id string, desired, live interface{}, fields []FieldChange) {
+	p.Changes = append(p.Changes, Change{
+		Action:  action,
+		Kind:    kind,
+		Phase:   phase,
+		Name:    name,
+		ID:      id,
+		Fields:  fields,
+		Desired: desired,
+		Live:    live,
+	})
+}
+
+// pair adds the creation of a resource of the manifest without a match in the account, or its
+// update when the match has different settings
+func (p *Plan) pair(kind, phase string, j int, desired interface{}, live func(j int) (interface{}, string)) {
+	name := reflect.ValueOf(desired).Elem().FieldByName("Name").String()
+	if j < 0 {
+		p.add(ActionCreate, kind, phase, name, "", desired, nil, nil)
+		return
+	}
+	have, liveID := live(j)
+	if fields := Compare(desired, have); len(fields) > 0 {
+		p.add(ActionUpdate, kind, phase, name, liveID, desired, have, fields)
+	}
+}
+
+// ref identifies a resource by the ID recorded in the manifest and by its name
+type ref struct {
+	ID   string
+	Name string
+}
+
+// match pairs every resource of the manifest with the resource of the account that has its
+// recorded ID or, when it has none or the ID is gone, its name. It returns the index of the
+// account resource of each manifest resource, or -1, and the account resources left unpaired
+func match(desired, live []ref) ([]int, []int) {
+	used := make([]bool, len(live))
+	matched := make([]int, len(desired))
+	find := func(same func(ref, ref) bool, d ref) int {
+		for j, l := range live {
+			if !used[j] && same(d, l) {
+				return j
+			}
+		}
+		return -1
+	}
+
+	for i := range matched {
+		matched[i] = -1
+		if desired[i].ID == "" {
+			continue
+		}
+		if j := find(func(d, l ref) bool { return d.ID == l.ID }, desired[i]); j >= 0 {
+			matched[i], used[j] = j, true
+		}
+	}
+	for i := range matched {
+		if matched[i] >= 0 {
+			continue
+		}
+		if j := find(func(d, l ref) bool { return d.Name == l.Name }, desired[i]); j >= 0 {
+			matched[i], used[j] = j, true
+		}
+	}
+
+	var unmatched []int
+	for j := range live {
+		if !used[j] {
+			unmatched = append(unmatched, j)
+		}
+	}
+	return matched, unmatched
+}
+
+func id(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatInt(n, 10)
+}
+
+func deviceGroupRefs(list []DeviceGroup) []ref {
+	refs := make([]ref, len(list))
+	for i, r := range list {
+		refs[i] = ref{ID: id(r.ID), Name: r.Name}
+	}
+	return refs
+}
+
+func originRefs(list []Origin) []ref {
+	refs := make([]ref, len(list))
+	for i, r := range list {
+		refs[i] = ref{ID: r.Key, Name: r.Name}
+	}
+	return refs
+}
+
+func cacheSettingRefs(list []CacheSetting) []ref {
+	refs := make([]ref, len(list))
+	for i, r := range list {
+		refs[i] = ref{ID: id(r.ID), Name: r.Name}
+	}
+	return refs
+}
+
+func functionInstanceRefs(list []FunctionInstance) []ref {
+	refs := make([]ref, len(list))
+	for i, r := range list {
+		refs[i] = ref{ID: id(r.ID), Name: r.Name}
+	}
+	return refs
+}
+
+func ruleRefs(list []Rule) []ref {
+	refs := make([]ref, len(list))
+	for i, r := range list {
+		refs[i] = ref{ID: id(r.ID), Name: r.Name}
+	}
+	return refs
+}
+
+func domainRefs(list []Domain) []ref {
+	refs := make([]ref, len(list))
+	for i, r := range list {
+		refs[i] = ref{ID: id(r.ID), Name: r.Name}
+	}
+	return refs
+}
+
+// toMap decodes a resource into the map of the JSON fields it sets
+func toMap(v interface{}) map[string]interface{} {
+	m := map[string]interface{}{}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return m
+	}
+	_ = json.Unmarshal(data, &m)
+	return m
+}
+
+// jsonFields returns the JSON names of the fields of a struct, in the order they are declared
+func jsonFields(t reflect.Type) []string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != "-" {
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
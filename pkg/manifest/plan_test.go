@@ -0,0 +1,122 @@
+package manifest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	ttl := int64(3600)
+	want := &CacheSetting{ID: 1, Name: "static", BrowserCacheSettingsMaximumTtl: &ttl, CookieNames: []string{"session"}}
+	have := &CacheSetting{ID: 2, Name: "static", BrowserCacheSettings: "honor", BrowserCacheSettingsMaximumTtl: int64Ptr(60)}
+
+	require.Equal(t, []FieldChange{
+		{Field: "browser_cache_settings_maximum_ttl", Old: float64(60), New: float64(3600)},
+		{Field: "cookie_names", Old: nil, New: []interface{}{"session"}},
+	}, Compare(want, have))
+
+	have.BrowserCacheSettingsMaximumTtl = &ttl
+	have.CookieNames = []string{"session"}
+	require.Empty(t, Compare(want, have))
+
+	// the keys of the account are kept when the manifest leaves them out, and secrets are masked
+	live := &Origin{Name: "api", HmacAccessKey: "AKIA", HmacSecretKey: "secret"}
+	require.Empty(t, Compare(&Origin{Name: "api"}, live))
+	require.Equal(t, []FieldChange{
+		{Field: "hmac_secret_key", Old: "(sensitive)", New: "(sensitive)"},
+	}, Compare(&Origin{Name: "api", HmacSecretKey: "rotated"}, live))
+}
+
+func TestNewPlan(t *testing.T) {
+	t.Run("new edge application", func(t *testing.T) {
+		desired := &Manifest{EdgeApplication: Application{
+			Name:    "my-app",
+			Origins: []Origin{{Name: "api"}},
+			Domains: []Domain{{Name: "www"}},
+		}}
+
+		plan := NewPlan(desired, nil)
+		require.Equal(t, []string{
+			"create edge application my-app",
+			"create origin api",
+			"create domain www",
+		}, summary(plan))
+	})
+
+	t.Run("existing edge application", func(t *testing.T) {
+		desired := &Manifest{EdgeApplication: Application{
+			Name:          "my-app",
+			Caching:       boolPtr(true),
+			Origins:       []Origin{{Name: "api", Addresses: []string{"api.example.com"}}, {Key: "k2", Name: "renamed"}},
+			CacheSettings: []CacheSetting{{Name: "static"}},
+			RulesEngine: RulesEngine{
+				Response: []Rule{{ID: 30, Name: "headers"}},
+			},
+		}}
+		live := &Manifest{EdgeApplication: Application{
+			ID:            1234,
+			Name:          "my-app",
+			Caching:       boolPtr(false),
+			Origins:       []Origin{{ID: 1, Key: "k1", Name: "api", Addresses: []string{"api.example.com"}}, {ID: 2, Key: "k2", Name: "old"}},
+			CacheSettings: []CacheSetting{{ID: 10, Name: "Default Cache Settings"}},
+			RulesEngine: RulesEngine{
+				Request:  []Rule{{ID: 20, Name: "Default Rule", Default: true}},
+				Response: []Rule{{ID: 30, Name: "old headers"}, {ID: 31, Name: "legacy"}},
+			},
+		}}
+
+		plan := NewPlan(desired, live)
+		require.Equal(t, []string{
+			"update edge application my-app",
+			"update origin renamed",
+			"create cache setting static",
+			"update rule headers",
+			"delete rule legacy",
+			"delete cache setting Default Cache Settings",
+		}, summary(plan))
+		require.Equal(t, []FieldChange{{Field: "caching", Old: false, New: true}}, plan.Changes[0].Fields)
+		require.Equal(t, "response", plan.Changes[3].Phase)
+
+		create, update, del := plan.Count()
+		require.Equal(t, []int{1, 3, 2}, []int{create, update, del})
+
+		// the IDs of the matches are recorded in the manifest
+		require.Equal(t, int64(1234), desired.EdgeApplication.ID)
+		require.Equal(t, "k1", desired.EdgeApplication.Origins[0].Key)
+		require.Equal(t, int64(1), desired.EdgeApplication.Origins[0].ID)
+		require.Equal(t, int64(0), desired.EdgeApplication.CacheSettings[0].ID)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		desired := &Manifest{EdgeApplication: Application{Name: "my-app"}}
+		live := &Manifest{EdgeApplication: Application{ID: 1, Name: "my-app", Caching: boolPtr(true)}}
+		require.True(t, NewPlan(desired, live).Empty())
+	})
+}
+
+func TestPrint(t *testing.T) {
+	color.NoColor = true
+	plan := &Plan{}
+	plan.add(ActionCreate, KindOrigin, "", "api", "", nil, nil, nil)
+	plan.add(ActionUpdate, KindRule, "request", "Default Rule", "20", nil, nil, []FieldChange{{Field: "description", Old: nil, New: "main"}})
+	plan.add(ActionDelete, KindDomain, "", "www", "5", nil, nil, nil)
+
+	out := &bytes.Buffer{}
+	plan.Print(out)
+	require.Equal(t, `  + origin "api"
+  ~ request rule "Default Rule" (20)
+      description: (unset) -> "main"
+  - domain "www" (5)
+`, out.String())
+}
+
+func summary(p *Plan) []string {
+	var lines []string
+	for _, c := range p.Changes {
+		lines = append(lines, string(c.Action)+" "+c.Kind+" "+c.Name)
+	}
+	return lines
+}
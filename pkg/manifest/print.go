@@ -0,0 +1,48 @@
+package manifest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+)
+
+var symbols = map[Action]struct {
+	symbol string
+	color  *color.Color
+}{
+	ActionCreate: {"+", color.New(color.FgGreen)},
+	ActionUpdate: {"~", color.New(color.FgYellow)},
+	ActionDelete: {"-", color.New(color.FgRed)},
+}
+
+// Print writes a line for each change of the plan, followed by the fields an update changes
+func (p *Plan) Print(w io.Writer) {
+	for _, c := range p.Changes {
+		s := symbols[c.Action]
+		kind := c.Kind
+		if c.Phase != "" {
+			kind = c.Phase + " " + kind
+		}
+		line := fmt.Sprintf("%s %s %q", s.symbol, kind, c.Name)
+		if c.ID != "" {
+			line += fmt.Sprintf(" (%s)", c.ID)
+		}
+		fmt.Fprintf(w, "  %s\n", s.color.Sprint(line))
+		for _, f := range c.Fields {
+			fmt.Fprintf(w, "      %s: %s -> %s\n", f.Field, formatValue(f.Old), formatValue(f.New))
+		}
+	}
+}
+
+func formatValue(v interface{}) string {
+	if v == nil {
+		return "(unset)"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,494 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+
+	table "github.com/MaxwelMazur/tablecli"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/fatih/color"
+	"gopkg.in/yaml.v2"
+)
+
+// Formats accepted by the --format flag of list and describe commands
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatYAML  = "yaml"
+	FormatCSV   = "csv"
+	FormatTSV   = "tsv"
+)
+
+// Formats lists every format, with the default one first
+var Formats = []string{FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatTSV}
+
+// Field is a labeled value shown by describe commands
+type Field struct {
+	Label string
+	Value interface{}
+}
+
+// Printer writes the resources of list and describe commands in one of the Formats.
+// Table, CSV and TSV rows are written on each Flush, so paginated lists show the pages as they arrive,
+// while JSON and YAML keep the resources and write them on Close. Select, SetQuery and SetTemplate
+// change what is written, see view.go
+type Printer struct {
+	// Written is called with the path of the file of Open once it is written
+	Written func(path string)
+	// NoHeader leaves out the table header, as paginated lists do when they start after the first page
+	NoHeader bool
+	// Color colors the table header, first column and describe labels
+	Color bool
+	// Width is the number of columns tables fit in by truncating their longest cells, 0 for no limit
+	Width int
+
+	w       io.Writer
+	file    *os.File
+	closed  bool
+	format  string
+	headers []string
+	rows    [][]string
+	items   []interface{}
+	started bool
+	err     error
+
+	fields   []string
+	query    *Query
+	template *template.Template
+	executed int
+}
+
+// ValidFormat reports whether the format is one of the Formats
+func ValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// New returns a printer of resources with the columns of headers
+func New(w io.Writer, format string, headers ...string) (*Printer, error) {
+	if !ValidFormat(format) {
+		return nil, fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(Formats, ", "))
+	}
+	return &Printer{w: w, format: format, headers: headers}, nil
+}
+
+// Open returns a printer that writes to the file at path, creating its directories, or to w when path is empty
+func Open(w io.Writer, path, format string, headers ...string) (*Printer, error) {
+	p, err := New(w, format, headers...)
+	if err != nil || path == "" {
+		return p, err
+	}
+	if err := p.OpenFile(path); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// OpenFile makes the printer write to the file at path, creating its directories. The file is closed by Close
+func (p *Printer) OpenFile(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	p.w, p.file = file, file
+	return nil
+}
+
+// AddRow adds a resource, shown by JSON and YAML, and the values of its columns
+func (p *Printer) AddRow(item interface{}, values ...interface{}) {
+	p.items = append(p.items, item)
+	if p.fields != nil {
+		values = p.selected(item)
+	}
+	if len(values) > len(p.headers) {
+		values = values[:len(p.headers)]
+	}
+	row := make([]string, len(values))
+	for i, v := range values {
+		row[i] = ToString(v)
+	}
+	p.rows = append(p.rows, row)
+}
+
+// AddRows adds every element of elems with the columns of fields, as in BuildRows
+func (p *Printer) AddRows(elems interface{}, fields []string) {
+	rows := BuildRows(elems, fields)
+	slice := reflect.ValueOf(elems)
+	for i, row := range rows {
+		values := make([]interface{}, len(row))
+		for j, value := range row {
+			values[j] = value
+		}
+		p.AddRow(slice.Index(i).Interface(), values...)
+	}
+}
+
+// Flush writes the rows added since the previous flush. The header is written only once
+func (p *Printer) Flush() error {
+	rows := p.rows
+	p.rows = nil
+	if p.err != nil {
+		return p.err
+	}
+
+	switch {
+	case p.template != nil:
+		return p.executeTemplate()
+	case p.query != nil:
+		return nil
+	}
+
+	switch p.format {
+	case FormatTable:
+		p.printTable(rows)
+	case FormatCSV:
+		w := csv.NewWriter(p.w)
+		if !p.started {
+			_ = w.Write(p.headers)
+		}
+		_ = w.WriteAll(rows)
+		if err := w.Error(); err != nil {
+			return err
+		}
+	case FormatTSV:
+		if !p.started {
+			fmt.Fprint(p.w, BuildLine(p.headers))
+		}
+		for _, row := range rows {
+			fmt.Fprint(p.w, BuildLine(row))
+		}
+	}
+
+	p.started = true
+	return nil
+}
+
+// Close writes what is left and closes the file of Open. JSON and YAML write every resource as a list
+func (p *Printer) Close() error {
+	if p.closed {
+		return nil
+	}
+	p.closed = true
+
+	err := p.Flush()
+	if err == nil && p.template == nil && (p.query != nil || p.format == FormatJSON || p.format == FormatYAML) {
+		items := make([]interface{}, len(p.items))
+		for i, item := range p.items {
+			if items[i], err = p.project(item); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = p.write(items)
+		}
+	}
+	return p.closeFile(err)
+}
+
+func (p *Printer) closeFile(err error) error {
+	if p.file == nil {
+		return err
+	}
+	if closeErr := p.file.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil && p.Written != nil {
+		p.Written(p.file.Name())
+	}
+	return err
+}
+
+// DefaultTable reports whether the printer writes a table of the default columns, which commands with
+// their own table layout write with Text
+func (p *Printer) DefaultTable() bool {
+	return p.format == FormatTable && p.fields == nil && p.query == nil && p.template == nil
+}
+
+// Text writes data as it is and closes the printer, for commands with their own table layout
+func (p *Printer) Text(data []byte) error {
+	p.closed = true
+	_, err := p.w.Write(data)
+	return p.closeFile(err)
+}
+
+// Describe writes a single resource and closes the printer. Table and TSV show one labeled value
+// per line, CSV shows the labels as the header, and JSON and YAML show the whole resource
+func (p *Printer) Describe(item interface{}, fields []Field) error {
+	p.closed = true
+	return p.closeFile(p.describe(item, fields))
+}
+
+func (p *Printer) describe(item interface{}, fields []Field) error {
+	if p.template != nil {
+		p.items = []interface{}{item}
+		return p.executeTemplate()
+	}
+	if p.fields != nil {
+		values := p.selected(item)
+		if p.err != nil {
+			return p.err
+		}
+		fields = make([]Field, len(p.fields))
+		for i, name := range p.fields {
+			fields[i] = Field{Label: name, Value: values[i]}
+		}
+	}
+
+	if p.query != nil || p.format == FormatJSON || p.format == FormatYAML {
+		data, err := p.project(item)
+		if err != nil {
+			return err
+		}
+		return p.write(data)
+	}
+
+	switch p.format {
+	case FormatCSV:
+		headers := make([]string, len(fields))
+		values := make([]string, len(fields))
+		for i, field := range fields {
+			headers[i], values[i] = field.Label, ToString(field.Value)
+		}
+		w := csv.NewWriter(p.w)
+		_ = w.WriteAll([][]string{headers, values})
+		return w.Error()
+	case FormatTSV:
+		for _, field := range fields {
+			fmt.Fprint(p.w, BuildLine([]string{field.Label, ToString(field.Value)}))
+		}
+		return nil
+	}
+
+	tbl := table.New("", "")
+	tbl.WithFirstColumnFormatter(p.colorFunc(color.FgGreen))
+	for _, field := range fields {
+		list, ok := field.Value.([]string)
+		if !ok {
+			tbl.AddRow(field.Label+": ", ToString(field.Value))
+			continue
+		}
+		tbl.AddRow(field.Label + ": ")
+		for _, value := range list {
+			tbl.AddRow("	", value)
+		}
+	}
+	_, err := p.w.Write(tbl.GetByteFormat())
+	return err
+}
+
+func (p *Printer) printTable(rows [][]string) {
+	headers := make([]interface{}, len(p.headers))
+	for i, h := range p.headers {
+		headers[i] = h
+	}
+
+	tbl := table.New(headers...)
+	tbl.WithWriter(p.w)
+	headerFmt := p.colorFunc(color.FgBlue, color.Underline)
+	columnFmt := p.colorFunc(color.FgGreen)
+	tbl.WithHeaderFormatter(headerFmt).WithFirstColumnFormatter(columnFmt)
+	tbl.SetRows(fit(p.Width, p.headers, rows))
+
+	format := strings.Repeat("%s", len(tbl.GetHeader())) + "\n"
+	tbl.CalculateWidths([]string{})
+	if !p.started && !p.NoHeader {
+		logger.PrintHeader(tbl, format)
+	}
+	for _, row := range tbl.GetRows() {
+		logger.PrintRow(tbl, format, row)
+	}
+}
+
+// colorFunc returns a formatter with the attributes, or one that leaves the text as it is without Color
+func (p *Printer) colorFunc(attrs ...color.Attribute) table.Formatter {
+	c := color.New(attrs...)
+	if p.Color {
+		c.EnableColor()
+	} else {
+		c.DisableColor()
+	}
+	return c.SprintfFunc()
+}
+
+// minCellWidth is the width fit doesn't truncate cells below
+const minCellWidth = 8
+
+// fit truncates the longest cells, ending them with "...", until the rows and the header fit in width.
+// Each column takes the width of its longest cell plus the padding of the table
+func fit(width int, headers []string, rows [][]string) [][]string {
+	if width <= 0 || len(headers) == 0 {
+		return rows
+	}
+
+	widths := make([]int, len(headers))
+	for i, h := range headers {
+		widths[i] = utf8.RuneCountInString(h)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			if n := utf8.RuneCountInString(cell); i < len(widths) && n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	available := width - table.DefaultPadding*len(widths)
+	total := 0
+	for _, w := range widths {
+		total += w
+	}
+	truncated := false
+	for total > available {
+		widest := 0
+		for i, w := range widths {
+			if w > widths[widest] {
+				widest = i
+			}
+		}
+		if widths[widest] <= minCellWidth {
+			break
+		}
+		widths[widest]--
+		total--
+		truncated = true
+	}
+	if !truncated {
+		return rows
+	}
+
+	fitted := make([][]string, len(rows))
+	for i, row := range rows {
+		fitted[i] = make([]string, len(row))
+		for j, cell := range row {
+			if j < len(widths) {
+				cell = truncate(cell, widths[j])
+			}
+			fitted[i][j] = cell
+		}
+	}
+	return fitted
+}
+
+// truncate shortens s to n runes, ending it with "..."
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-3]) + "..."
+}
+
+// encode writes JSON or YAML. YAML goes through JSON so both use the same field names and order
+func (p *Printer) encode(v interface{}) error {
+	return p.marshal(v, p.format == FormatYAML)
+}
+
+func (p *Printer) marshal(v interface{}, asYAML bool) error {
+	data, err := json.MarshalIndent(v, "", " ")
+	if err != nil {
+		return err
+	}
+
+	if !asYAML {
+		_, err = p.w.Write(append(data, '\n'))
+		return err
+	}
+
+	ordered, err := ordered(json.NewDecoder(bytes.NewReader(data)))
+	if err != nil {
+		return err
+	}
+	out, err := yaml.Marshal(ordered)
+	if err != nil {
+		return err
+	}
+	_, err = p.w.Write(out)
+	return err
+}
+
+// ordered decodes the next JSON value keeping the order of the object keys
+func ordered(dec *json.Decoder) (interface{}, error) {
+	dec.UseNumber()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok {
+	case json.Delim('{'):
+		obj := object{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := ordered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = append(obj, yaml.MapItem{Key: key, Value: value})
+		}
+		_, err := dec.Token()
+		return obj, err
+	case json.Delim('['):
+		list := []interface{}{}
+		for dec.More() {
+			value, err := ordered(dec)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, value)
+		}
+		_, err := dec.Token()
+		return list, err
+	}
+
+	if n, ok := tok.(json.Number); ok {
+		if i, err := n.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := n.Float64()
+		return f, err
+	}
+	return tok, nil
+}
+
+// ToString formats a value for table, CSV and TSV output. Pointers show the value they point to,
+// string lists are joined with commas and decoded JSON objects and lists are shown as JSON
+func ToString(value interface{}) string {
+	switch v := value.(type) {
+	case []string:
+		return strings.Join(v, ",")
+	case object, []interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+	v := reflect.ValueOf(value)
+	for v.IsValid() && v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return ""
+	}
+	return toString(v.Interface())
+}
@@ -0,0 +1,257 @@
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+type resource struct {
+	ID   int64   `json:"id"`
+	Name string  `json:"name"`
+	Tag  *string `json:"tag,omitempty"`
+}
+
+func (r resource) Label() string {
+	return fmt.Sprintf("%s #%d", r.Name, r.ID)
+}
+
+func TestPrinter(t *testing.T) {
+	tag := "edge"
+	resources := []resource{{ID: 1, Name: "first", Tag: &tag}, {ID: 2, Name: "second"}}
+
+	list := func(t *testing.T, format string) string {
+		out := bytes.NewBuffer(nil)
+		p, err := New(out, format, "ID", "NAME", "TAG")
+		require.NoError(t, err)
+
+		for _, r := range resources {
+			p.AddRow(r, r.ID, r.Name, r.Tag)
+			require.NoError(t, p.Flush())
+		}
+		require.NoError(t, p.Close())
+		return out.String()
+	}
+
+	t.Run("json", func(t *testing.T) {
+		require.Equal(t, `[
+ {
+  "id": 1,
+  "name": "first",
+  "tag": "edge"
+ },
+ {
+  "id": 2,
+  "name": "second"
+ }
+]
+`, list(t, FormatJSON))
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		require.Equal(t, `- id: 1
+  name: first
+  tag: edge
+- id: 2
+  name: second
+`, list(t, FormatYAML))
+	})
+
+	t.Run("csv writes the header once", func(t *testing.T) {
+		require.Equal(t, "ID,NAME,TAG\n1,first,edge\n2,second,\n", list(t, FormatCSV))
+	})
+
+	t.Run("tsv", func(t *testing.T) {
+		require.Equal(t, "ID\tNAME\tTAG\n1\tfirst\tedge\n2\tsecond\t\n", list(t, FormatTSV))
+	})
+
+	t.Run("empty json list", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		p, err := New(out, FormatJSON, "ID")
+		require.NoError(t, err)
+		require.NoError(t, p.Close())
+		require.Equal(t, "[]\n", out.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		_, err := New(bytes.NewBuffer(nil), "xml")
+		require.Error(t, err)
+	})
+}
+
+func TestDescribe(t *testing.T) {
+	r := resource{ID: 7, Name: "seventh"}
+	fields := []Field{
+		{Label: "ID", Value: r.ID},
+		{Label: "Name", Value: r.Name},
+		{Label: "Cnames", Value: []string{"a.com", "b.com"}},
+	}
+
+	describe := func(t *testing.T, format string) string {
+		out := bytes.NewBuffer(nil)
+		p, err := New(out, format)
+		require.NoError(t, err)
+		require.NoError(t, p.Describe(r, fields))
+		return out.String()
+	}
+
+	t.Run("yaml shows the resource", func(t *testing.T) {
+		require.Equal(t, "id: 7\nname: seventh\n", describe(t, FormatYAML))
+	})
+
+	t.Run("csv shows the labels as the header", func(t *testing.T) {
+		require.Equal(t, "ID,Name,Cnames\n7,seventh,\"a.com,b.com\"\n", describe(t, FormatCSV))
+	})
+
+	t.Run("tsv shows one field per line", func(t *testing.T) {
+		require.Equal(t, "ID\t7\nName\tseventh\nCnames\ta.com,b.com\n", describe(t, FormatTSV))
+	})
+}
+
+func TestTable(t *testing.T) {
+	logger.New(zapcore.DebugLevel)
+	list := func(t *testing.T, setup func(p *Printer), rows ...[]string) string {
+		out := bytes.NewBuffer(nil)
+		p, err := New(out, FormatTable, "ID", "NAME")
+		require.NoError(t, err)
+		setup(p)
+		for _, row := range rows {
+			p.AddRow(nil, row[0], row[1])
+		}
+		require.NoError(t, p.Close())
+		return out.String()
+	}
+
+	t.Run("no colors", func(t *testing.T) {
+		out := list(t, func(p *Printer) {}, []string{"1", "first"})
+		require.NotContains(t, out, "\x1b[")
+	})
+
+	t.Run("colors", func(t *testing.T) {
+		out := list(t, func(p *Printer) { p.Color = true }, []string{"1", "first"})
+		require.Contains(t, out, "\x1b[")
+	})
+
+	t.Run("fit the width", func(t *testing.T) {
+		require.Equal(t, [][]string{{"1", "a long na..."}}, fit(18, []string{"ID", "NAME"}, [][]string{{"1", "a long name to cut"}}))
+	})
+
+	t.Run("short rows are kept", func(t *testing.T) {
+		rows := [][]string{{"1", "first"}}
+		require.Equal(t, rows, fit(80, []string{"ID", "NAME"}, rows))
+		require.Equal(t, rows, fit(0, []string{"ID", "NAME"}, rows))
+	})
+
+	t.Run("cells keep a minimum width", func(t *testing.T) {
+		require.Equal(t, [][]string{{"12345...", "abcde..."}}, fit(4, []string{"ID", "NAME"}, [][]string{{"1234567890", "abcdefghij"}}))
+	})
+}
+
+func TestOpen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out", "resource.json")
+
+	var written string
+	p, err := Open(bytes.NewBuffer(nil), path, FormatJSON)
+	require.NoError(t, err)
+	p.Written = func(path string) { written = path }
+
+	require.NoError(t, p.Describe(resource{ID: 3, Name: "third"}, nil))
+	require.Equal(t, path, written)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{\n \"id\": 3,\n \"name\": \"third\"\n}\n", string(data))
+}
+
+func TestViews(t *testing.T) {
+	tag := "edge"
+	resources := []resource{{ID: 1, Name: "first", Tag: &tag}, {ID: 2, Name: "second"}}
+
+	list := func(t *testing.T, format string, view func(p *Printer)) string {
+		out := bytes.NewBuffer(nil)
+		p, err := New(out, format, "ID", "NAME", "TAG")
+		require.NoError(t, err)
+		view(p)
+
+		for _, r := range resources {
+			p.AddRow(r, r.ID, r.Name, r.Tag)
+		}
+		require.NoError(t, p.Close())
+		return out.String()
+	}
+
+	t.Run("fields choose the columns", func(t *testing.T) {
+		out := list(t, FormatCSV, func(p *Printer) { p.Select([]string{"name", " Tag", "ID"}) })
+		require.Equal(t, "NAME,TAG,ID\nfirst,edge,1\nsecond,,2\n", out)
+	})
+
+	t.Run("fields fall back to Go fields and methods", func(t *testing.T) {
+		out := list(t, FormatTSV, func(p *Printer) { p.Select([]string{"Name", "Label()"}) })
+		require.Equal(t, "NAME\tLABEL()\nfirst\tfirst #1\nsecond\tsecond #2\n", out)
+	})
+
+	t.Run("fields project json", func(t *testing.T) {
+		out := list(t, FormatJSON, func(p *Printer) { p.Select([]string{"name"}) })
+		require.JSONEq(t, `[{"name": "first"}, {"name": "second"}]`, out)
+	})
+
+	t.Run("query writes text results as they are", func(t *testing.T) {
+		out := list(t, FormatTable, func(p *Printer) { require.NoError(t, p.SetQuery("#(id>1).name")) })
+		require.Equal(t, "second\n", out)
+	})
+
+	t.Run("query writes objects in the format", func(t *testing.T) {
+		out := list(t, FormatYAML, func(p *Printer) { require.NoError(t, p.SetQuery("0.{id,tag}")) })
+		require.Equal(t, "id: 1\ntag: edge\n", out)
+	})
+
+	t.Run("query runs on the selected fields", func(t *testing.T) {
+		out := list(t, FormatJSON, func(p *Printer) {
+			p.Select([]string{"id"})
+			require.NoError(t, p.SetQuery("1"))
+		})
+		require.Equal(t, "{\n \"id\": 2\n}\n", out)
+	})
+
+	t.Run("template", func(t *testing.T) {
+		out := list(t, FormatTable, func(p *Printer) { require.NoError(t, p.SetTemplate("{{.ID}}={{.Name}}")) })
+		require.Equal(t, "1=first\n2=second\n", out)
+	})
+
+	t.Run("describe", func(t *testing.T) {
+		out := bytes.NewBuffer(nil)
+		p, err := New(out, FormatTSV)
+		require.NoError(t, err)
+		p.Select([]string{"id", "tag"})
+		require.NoError(t, p.Describe(resources[0], []Field{{Label: "Name", Value: "ignored"}}))
+		require.Equal(t, "id\t1\ntag\tedge\n", out.String())
+
+		out.Reset()
+		p, err = New(out, FormatTable)
+		require.NoError(t, err)
+		require.NoError(t, p.SetQuery("tag"))
+		require.NoError(t, p.Describe(resources[0], nil))
+		require.Equal(t, "edge\n", out.String())
+
+		out.Reset()
+		p, err = New(out, FormatTable)
+		require.NoError(t, err)
+		require.NoError(t, p.SetTemplate("{{.Name}} {{json .Tag}}"))
+		require.NoError(t, p.Describe(resources[0], nil))
+		require.Equal(t, "first \"edge\"\n", out.String())
+	})
+
+	t.Run("default table", func(t *testing.T) {
+		p, err := New(bytes.NewBuffer(nil), FormatTable)
+		require.NoError(t, err)
+		require.True(t, p.DefaultTable())
+		p.Select([]string{"id"})
+		require.False(t, p.DefaultTable())
+	})
+}
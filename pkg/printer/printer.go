@@ -81,23 +81,8 @@ func BuildRows(elems interface{}, fields []string) [][]string {
 		var columns []string
 
 		for _, name := range fields {
-			var value string
-
-			if strings.Contains(name, "()") {
-				// The field is a method, get it and call it
-				methodName := strings.Replace(name, "()", "", -1)
-				method := slice.Index(i).MethodByName(methodName)
-				results := method.Call(nil)
-				if len(results) > 0 {
-					value = toString(results[0])
-				}
-			} else {
-				// Struct field
-				field := slice.Index(i).FieldByName(name).Interface()
-				value = toString(field)
-			}
-
-			columns = append(columns, value)
+			value, _ := lookupGo(slice.Index(i), name)
+			columns = append(columns, toString(value))
 		}
 
 		rows = append(rows, columns)
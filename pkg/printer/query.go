@@ -0,0 +1,113 @@
+package printer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"gopkg.in/yaml.v2"
+)
+
+// Query is a GJSON path run on the JSON view of command output, see
+// https://github.com/tidwall/gjson/blob/master/SYNTAX.md.
+//
+// It supports paths such as count, results.0.id and results.#.name, the length of a list with
+// results.#, queries such as results.#(active==true)#.name that can be chained with the pipe,
+// modifiers such as @keys and multipaths such as {id,label:name}
+type Query struct {
+	path string
+}
+
+// ParseQuery checks a query expression. GJSON accepts any path, so only empty paths and
+// unbalanced brackets, parentheses, braces or quotes are rejected
+func ParseQuery(expr string) (*Query, error) {
+	if strings.TrimSpace(expr) == "" {
+		return nil, errors.New("the query is empty")
+	}
+
+	var open []rune
+	closing := map[rune]rune{')': '(', ']': '[', '}': '{'}
+	var quote rune
+	escaped := false
+	for _, r := range expr {
+		switch {
+		case escaped:
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '"':
+			quote = r
+		case r == '(' || r == '[' || r == '{':
+			open = append(open, r)
+		case r == ')' || r == ']' || r == '}':
+			if len(open) == 0 || open[len(open)-1] != closing[r] {
+				return nil, fmt.Errorf("unexpected %q in query", r)
+			}
+			open = open[:len(open)-1]
+		}
+	}
+	if quote != 0 {
+		return nil, errors.New("unterminated string in query")
+	}
+	if len(open) > 0 {
+		return nil, fmt.Errorf("unclosed %q in query", open[len(open)-1])
+	}
+	return &Query{path: expr}, nil
+}
+
+// Run returns the value the query finds in data, which is decoded JSON, or nil when nothing matches
+func (q *Query) Run(data interface{}) (interface{}, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := gjson.GetBytes(raw, q.path)
+	if !result.Exists() {
+		return nil, nil
+	}
+	return ordered(json.NewDecoder(strings.NewReader(result.Raw)))
+}
+
+// object is a decoded JSON object that keeps the order of its keys
+type object yaml.MapSlice
+
+func (o object) get(key string) (interface{}, bool) {
+	for _, item := range o {
+		if item.Key == key {
+			return item.Value, true
+		}
+	}
+	return nil, false
+}
+
+// MarshalJSON writes the object with its keys in order
+func (o object) MarshalJSON() ([]byte, error) {
+	b := []byte{'{'}
+	for i, item := range o {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		key, err := json.Marshal(fmt.Sprint(item.Key))
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(item.Value)
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(b, key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+// MarshalYAML writes the object with its keys in order
+func (o object) MarshalYAML() (interface{}, error) {
+	return yaml.MapSlice(o), nil
+}
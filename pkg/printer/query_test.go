@@ -0,0 +1,73 @@
+package printer
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) {
+	data, err := toData(json.RawMessage(`{
+		"count": 3,
+		"results": [
+			{"id": 1, "name": "first", "active": true, "tags": ["a", "b"]},
+			{"id": 2, "name": "second", "active": false, "tags": []},
+			{"id": 3, "name": "third", "active": true, "origin": {"host": "example.com"}}
+		]
+	}`))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{name: "key", query: "count", want: `3`},
+		{name: "key and index", query: "results.1.name", want: `"second"`},
+		{name: "every item", query: "results.#.id", want: `[1,2,3]`},
+		{name: "length", query: "results.#", want: `3`},
+		{name: "missing key", query: "results.0.origin.host", want: `null`},
+		{name: "first match", query: `results.#(name=="second").id`, want: `2`},
+		{name: "every match", query: "results.#(active==true)#.name", want: `["first","third"]`},
+		{name: "number comparison", query: "results.#(id>1)#.id", want: `[2,3]`},
+		{name: "string comparison", query: `results.#(name>="second")#.id`, want: `[2,3]`},
+		{name: "pattern", query: `results.#(name%"*ir*")#.id`, want: `[1,3]`},
+		{name: "chained queries", query: "results.#(active==true)#|#(id>1)#.name", want: `["third"]`},
+		{name: "nested query", query: `results.#(tags.#(=="b"))#.id`, want: `[1]`},
+		{name: "keys modifier", query: "results.0|@keys", want: `["id","name","active","tags"]`},
+		{name: "multipath", query: "results.0.{id,label:name}", want: `{"id":1,"label":"first"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuery(tt.query)
+			require.NoError(t, err)
+
+			result, err := q.Run(data)
+			require.NoError(t, err)
+
+			out, err := json.Marshal(result)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(out))
+		})
+	}
+
+	t.Run("keeps the order of the keys", func(t *testing.T) {
+		q, err := ParseQuery("results.0")
+		require.NoError(t, err)
+		result, err := q.Run(data)
+		require.NoError(t, err)
+
+		out, err := json.Marshal(result)
+		require.NoError(t, err)
+		require.Equal(t, `{"id":1,"name":"first","active":true,"tags":["a","b"]}`, string(out))
+	})
+
+	t.Run("invalid queries", func(t *testing.T) {
+		for _, query := range []string{"", " ", "results.#(id>1", "results.0.{id", `results.#(name=="open)`, "results)"} {
+			_, err := ParseQuery(query)
+			require.Error(t, err, query)
+		}
+	})
+}
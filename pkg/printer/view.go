@@ -0,0 +1,206 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// Select replaces the columns with fields. A field is a key of the JSON view of the resource, such
+// as id or last_editor, with dots for nested keys, or else the name of a Go field or method, such as
+// Id or GetId(), as in BuildRows. JSON and YAML show only the selected fields
+func (p *Printer) Select(fields []string) {
+	var selected []string
+	for _, field := range fields {
+		if field = strings.TrimSpace(field); field != "" {
+			selected = append(selected, field)
+		}
+	}
+	if len(selected) == 0 {
+		return
+	}
+
+	p.fields = selected
+	p.headers = make([]string, len(selected))
+	for i, field := range selected {
+		p.headers[i] = strings.ToUpper(field)
+	}
+}
+
+// SetQuery makes the printer write the result of a query, see Query, instead of the resources.
+// A list is queried as a whole once it is closed. Text results are written as they are and the
+// others as JSON, or YAML with the yaml format
+func (p *Printer) SetQuery(expr string) error {
+	query, err := ParseQuery(expr)
+	if err != nil {
+		return err
+	}
+	p.query = query
+	return nil
+}
+
+// SetTemplate makes the printer write each resource with a Go template, such as '{{.Id}}'. The
+// template runs on the resource as the command returns it, and json writes a value as JSON
+func (p *Printer) SetTemplate(text string) error {
+	tmpl, err := template.New("output").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return err
+	}
+	p.template = tmpl
+	return nil
+}
+
+// selected returns the values of the selected fields of item
+func (p *Printer) selected(item interface{}) []interface{} {
+	values := make([]interface{}, len(p.fields))
+	data, err := toData(item)
+	if err != nil {
+		p.err = err
+		return values
+	}
+
+	for i, field := range p.fields {
+		value, ok := lookupData(data, field)
+		if !ok {
+			value, _ = lookupGo(reflect.ValueOf(item), field)
+		}
+		values[i] = value
+	}
+	return values
+}
+
+// project returns the item with only the selected fields, or the item itself without a selection
+func (p *Printer) project(item interface{}) (interface{}, error) {
+	if p.fields == nil {
+		return item, nil
+	}
+
+	values := p.selected(item)
+	if p.err != nil {
+		return nil, p.err
+	}
+	obj := make(object, len(p.fields))
+	for i, field := range p.fields {
+		obj[i].Key, obj[i].Value = field, values[i]
+	}
+	return obj, nil
+}
+
+// write encodes v, or the results of the query on v
+func (p *Printer) write(v interface{}) error {
+	if p.query == nil {
+		return p.encode(v)
+	}
+
+	data, err := toData(v)
+	if err != nil {
+		return err
+	}
+	result, err := p.query.Run(data)
+	if err != nil {
+		return err
+	}
+
+	switch value := result.(type) {
+	case object, []interface{}:
+		return p.marshal(value, p.format == FormatYAML)
+	case nil:
+		_, err = fmt.Fprintln(p.w, "null")
+	default:
+		_, err = fmt.Fprintln(p.w, value)
+	}
+	return err
+}
+
+func (p *Printer) executeTemplate() error {
+	for _, item := range p.items[p.executed:] {
+		var b bytes.Buffer
+		if err := p.template.Execute(&b, item); err != nil {
+			return err
+		}
+		if b.Len() > 0 && !bytes.HasSuffix(b.Bytes(), []byte("\n")) {
+			b.WriteByte('\n')
+		}
+		if _, err := p.w.Write(b.Bytes()); err != nil {
+			return err
+		}
+	}
+	p.executed = len(p.items)
+	return nil
+}
+
+// toData returns the JSON view of v, decoded with the order of its keys
+func toData(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return ordered(json.NewDecoder(bytes.NewReader(data)))
+}
+
+// lookupData finds a dotted path of keys in decoded JSON. Keys match regardless of case
+func lookupData(data interface{}, path string) (interface{}, bool) {
+	for _, key := range strings.Split(path, ".") {
+		obj, ok := data.(object)
+		if !ok {
+			return nil, false
+		}
+		if data, ok = obj.get(key); ok {
+			continue
+		}
+		for _, item := range obj {
+			if name, isString := item.Key.(string); isString && strings.EqualFold(name, key) {
+				data, ok = item.Value, true
+				break
+			}
+		}
+		if !ok {
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+// lookupGo returns a field of a struct, or the result of a method when name ends with ()
+func lookupGo(v reflect.Value, name string) (interface{}, bool) {
+	if method := strings.TrimSuffix(name, "()"); method != name {
+		m := v.MethodByName(method)
+		if !m.IsValid() && v.CanAddr() {
+			m = v.Addr().MethodByName(method)
+		}
+		if !m.IsValid() && v.Kind() == reflect.Ptr && !v.IsNil() {
+			m = v.Elem().MethodByName(method)
+		}
+		if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() == 0 {
+			return nil, false
+		}
+		return m.Call(nil)[0].Interface(), true
+	}
+
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	field := v.FieldByName(name)
+	if !field.IsValid() {
+		field = v.FieldByNameFunc(func(n string) bool { return strings.EqualFold(n, name) })
+	}
+	if !field.IsValid() || !field.CanInterface() {
+		return nil, false
+	}
+	return field.Interface(), true
+}
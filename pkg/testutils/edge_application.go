@@ -0,0 +1,44 @@
+package testutils
+
+import (
+	"embed"
+	"path"
+
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+)
+
+//go:embed testdata/edge_application
+var edgeApplicationData embed.FS
+
+// edgeApplicationFixtures are the files of testdata/edge_application with the responses of the API
+// for each resource of the edge application 1234
+var edgeApplicationFixtures = map[string]string{
+	"edge_applications/1234":                             "application.json",
+	"edge_applications/1234/origins":                     "origins.json",
+	"edge_applications/1234/cache_settings":              "cache_settings.json",
+	"edge_applications/1234/device_groups":               "empty.json",
+	"edge_applications/1234/functions_instances":         "empty.json",
+	"edge_applications/1234/rules_engine/request/rules":  "request_rules.json",
+	"edge_applications/1234/rules_engine/response/rules": "response_rules.json",
+}
+
+// EdgeApplicationFixture responds with a file of testdata/edge_application, such as origins.json
+func EdgeApplicationFixture(name string) httpmock.Responder {
+	data, err := edgeApplicationData.ReadFile(path.Join("testdata/edge_application", name))
+	if err != nil {
+		panic(err)
+	}
+	return httpmock.JSONFromString(string(data))
+}
+
+// RegisterEdgeApplication registers the GET requests the manifest commands send to read the edge
+// application 1234 and its resources. The responses in overrides, keyed by path, replace the fixtures
+func RegisterEdgeApplication(mock *httpmock.Registry, overrides map[string]httpmock.Responder) {
+	for p, name := range edgeApplicationFixtures {
+		responder, ok := overrides[p]
+		if !ok {
+			responder = EdgeApplicationFixture(name)
+		}
+		mock.Register(httpmock.REST("GET", p), responder)
+	}
+}
@@ -0,0 +1,118 @@
+package token
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	msg "github.com/aziontech/azion-cli/messages/root"
+	"golang.org/x/crypto/scrypt"
+)
+
+// PassphraseEnv is the environment variable that holds the passphrase of encrypted credentials
+const PassphraseEnv = "AZIONCLI_CREDENTIALS_PASSPHRASE"
+
+// encrypted tokens are saved as the prefix followed by base64(salt | nonce | ciphertext)
+const encryptedPrefix = "azion-encrypted:v1:"
+
+const (
+	saltSize = 16
+	keySize  = 32
+)
+
+// IsEncrypted reports whether a saved token is in the encrypted format
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, encryptedPrefix)
+}
+
+// EnvPassphrase returns the passphrase set in AZIONCLI_CREDENTIALS_PASSPHRASE
+func EnvPassphrase() string {
+	return os.Getenv(PassphraseEnv)
+}
+
+// Encrypt seals the token with AES-256-GCM, using a key derived from the passphrase with scrypt
+func Encrypt(token, passphrase string) (string, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", fmt.Errorf(msg.ErrorEncryptingToken.Error(), err)
+	}
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", fmt.Errorf(msg.ErrorEncryptingToken.Error(), err)
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", fmt.Errorf(msg.ErrorEncryptingToken.Error(), err)
+	}
+
+	sealed := append(salt, nonce...)
+	sealed = gcm.Seal(sealed, nonce, []byte(token), nil)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a token sealed by Encrypt
+func Decrypt(value, passphrase string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(strings.TrimSpace(value), encryptedPrefix))
+	if err != nil || len(data) < saltSize {
+		return "", msg.ErrorDecryptingToken
+	}
+
+	gcm, err := newGCM(passphrase, data[:saltSize])
+	if err != nil {
+		return "", msg.ErrorDecryptingToken
+	}
+
+	data = data[saltSize:]
+	if len(data) < gcm.NonceSize() {
+		return "", msg.ErrorDecryptingToken
+	}
+
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", msg.ErrorDecryptingToken
+	}
+	return string(plain), nil
+}
+
+// Reveal returns the token in plaintext. Encrypted tokens are decrypted with the passphrase
+// of AZIONCLI_CREDENTIALS_PASSPHRASE or, when it is not set, the one returned by ask
+func Reveal(value string, ask func() (string, error)) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	passphrase := EnvPassphrase()
+	if passphrase == "" {
+		if ask == nil {
+			return "", msg.ErrorPassphraseRequired
+		}
+
+		var err error
+		passphrase, err = ask()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return Decrypt(value, passphrase)
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -1,11 +1,14 @@
 package token
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	msg "github.com/aziontech/azion-cli/messages/root"
 
@@ -18,12 +21,14 @@ type HTTPClient interface {
 }
 
 type Token struct {
-	endpoint string
-	client   HTTPClient
-	filepath string
-	token    string
-	valid    bool
-	out      io.Writer
+	endpoint   string
+	client     HTTPClient
+	filepath   string
+	token      string
+	valid      bool
+	out        io.Writer
+	profile    string
+	passphrase string
 }
 
 const credentialsFilename = "credentials"
@@ -31,6 +36,22 @@ const credentialsFilename = "credentials"
 type Config struct {
 	Client HTTPClient
 	Out    io.Writer
+	// Profile receives the token. The credentials file is used for the default profile
+	Profile string
+	// Endpoint validates the token. The AuthURL set at link time is used when empty
+	Endpoint string
+	// Passphrase encrypts the saved token. Tokens that are already encrypted
+	// stay encrypted with the passphrase of AZIONCLI_CREDENTIALS_PASSPHRASE
+	Passphrase string
+}
+
+// UserInfo is the account and user that own a token
+type UserInfo struct {
+	Email       string `json:"email"`
+	FirstName   string `json:"first_name"`
+	LastName    string `json:"last_name"`
+	ClientID    string `json:"client_id"`
+	AccountName string `json:"account_name"`
 }
 
 func New(c *Config) (*Token, error) {
@@ -39,26 +60,27 @@ func New(c *Config) (*Token, error) {
 		return nil, err
 	}
 
+	endpoint := c.Endpoint
+	if endpoint == "" {
+		endpoint = constants.AuthURL
+	}
+
 	return &Token{
-		client:   c.Client,
-		endpoint: constants.AuthURL,
-		filepath: filepath.Join(dir, credentialsFilename),
-		out:      c.Out,
+		client:     c.Client,
+		endpoint:   endpoint,
+		filepath:   filepath.Join(dir, credentialsFilename),
+		out:        c.Out,
+		profile:    c.Profile,
+		passphrase: c.Passphrase,
 	}, nil
 }
 
 func (t *Token) Validate(token *string) (bool, error) {
-	req, err := http.NewRequest("GET", t.endpoint, nil)
-	if err != nil {
-		return false, err
-	}
-	req.Header.Add("Accept", "application/json; version=3")
-	req.Header.Add("Authorization", "token "+*token)
-
-	resp, err := t.client.Do(req)
+	resp, err := t.me(*token)
 	if err != nil {
 		return false, err
 	}
+	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
 		return false, nil
@@ -70,8 +92,60 @@ func (t *Token) Validate(token *string) (bool, error) {
 	return true, nil
 }
 
+// User returns the account and user that own the token. It returns nil when the token is invalid
+func (t *Token) User(token string) (*UserInfo, error) {
+	resp, err := t.me(token)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// the user may come wrapped in the results field, like in the other Azion APIs
+	wrapped := struct {
+		Results *UserInfo `json:"results"`
+	}{}
+	if err := json.Unmarshal(body, &wrapped); err == nil && wrapped.Results != nil {
+		return wrapped.Results, nil
+	}
+
+	user := &UserInfo{}
+	if err := json.Unmarshal(body, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (t *Token) me(token string) (*http.Response, error) {
+	req, err := http.NewRequest("GET", t.endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add("Accept", "application/json; version=3")
+	req.Header.Add("Authorization", "token "+token)
+
+	return t.client.Do(req)
+}
+
 func (t *Token) Save() error {
-	fbyte := []byte(t.token)
+	if t.profile != "" && t.profile != config.DefaultProfile {
+		return t.saveInProfile()
+	}
+
+	saved, _ := os.ReadFile(t.filepath)
+	value, err := t.seal(string(saved))
+	if err != nil {
+		return err
+	}
+	fbyte := []byte(value)
 
 	filepath, err := config.Dir()
 	if err != nil {
@@ -95,7 +169,104 @@ func (t *Token) Save() error {
 	return nil
 }
 
+func (t *Token) saveInProfile() error {
+	profiles, err := config.ReadProfiles()
+	if err != nil {
+		return err
+	}
+
+	profile := profiles.Profiles[t.profile]
+	value, err := t.seal(profile.Token)
+	if err != nil {
+		return err
+	}
+	profile.Token = value
+	profiles.Profiles[t.profile] = profile
+
+	if err := config.WriteProfiles(profiles); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(t.out, msg.TokenSavedInProfile, t.profile)
+	return nil
+}
+
+// seal returns the token as it must be saved over the previous value
+func (t *Token) seal(previous string) (string, error) {
+	passphrase := t.passphrase
+	if passphrase == "" && IsEncrypted(previous) {
+		passphrase = EnvPassphrase()
+		if passphrase == "" {
+			return "", msg.ErrorPassphraseRequired
+		}
+	}
+
+	if passphrase == "" {
+		return t.token, nil
+	}
+	return Encrypt(t.token, passphrase)
+}
+
+// Remove deletes the token of the profile and returns where it was stored.
+// The location is empty when there was no token to remove
+func Remove(profile string) (string, error) {
+	if profile != "" && profile != config.DefaultProfile {
+		profiles, err := config.ReadProfiles()
+		if err != nil {
+			return "", err
+		}
+
+		p, ok := profiles.Profiles[profile]
+		if !ok || p.Token == "" {
+			return "", nil
+		}
+		p.Token = ""
+		profiles.Profiles[profile] = p
+
+		return "profile " + profile, config.WriteProfiles(profiles)
+	}
+
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	path := filepath.Join(dir, credentialsFilename)
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	return path, err
+}
+
+// FilePath returns the path of the credentials file used by the default profile
+func FilePath() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, credentialsFilename), nil
+}
+
+// ReadFromDisk returns the token of the credentials file. Encrypted tokens are
+// decrypted with the passphrase of AZIONCLI_CREDENTIALS_PASSPHRASE
 func ReadFromDisk() (string, error) {
+	return Read(nil)
+}
+
+// Read returns the token of the credentials file. Encrypted tokens are decrypted with the
+// passphrase of AZIONCLI_CREDENTIALS_PASSPHRASE or, when it is not set, the one returned by ask
+func Read(ask func() (string, error)) (string, error) {
+	saved, err := ReadSaved()
+	if err != nil {
+		return "", err
+	}
+	return Reveal(saved, ask)
+}
+
+// ReadSaved returns the token of the credentials file as it was saved, so encrypted tokens
+// stay encrypted
+func ReadSaved() (string, error) {
 	dir, err := config.Dir()
 	if err != nil {
 		return "", fmt.Errorf("failed to get token dir: %w", err)
@@ -105,6 +276,80 @@ func ReadFromDisk() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	return string(filedata), nil
+}
+
+// WorldReadable reports whether the credentials file keeps a plaintext token that any user can read
+func WorldReadable() bool {
+	path, err := FilePath()
+	if err != nil {
+		return false
+	}
 
-	return string(filedata[:]), nil
+	info, err := os.Stat(path)
+	if err != nil || info.Mode().Perm()&0o004 == 0 {
+		return false
+	}
+
+	data, err := os.ReadFile(path)
+	return err == nil && !IsEncrypted(string(data))
+}
+
+// Migrate encrypts the plaintext tokens of the credentials file and of the profiles with the
+// passphrase. It returns where the tokens were encrypted
+func Migrate(passphrase string) ([]string, error) {
+	var migrated []string
+
+	path, err := FilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if tok := strings.TrimSpace(string(data)); tok != "" && !IsEncrypted(tok) {
+		value, err := Encrypt(tok, passphrase)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, []byte(value), 0600); err != nil {
+			return nil, err
+		}
+		// WriteFile keeps the mode of existing files
+		if err := os.Chmod(path, 0600); err != nil {
+			return nil, err
+		}
+		migrated = append(migrated, path)
+	}
+
+	profiles, err := config.ReadProfiles()
+	if err != nil {
+		return migrated, err
+	}
+
+	changed := false
+	for _, name := range profiles.Names() {
+		profile := profiles.Profiles[name]
+		if profile.Token == "" || IsEncrypted(profile.Token) {
+			continue
+		}
+
+		value, err := Encrypt(profile.Token, passphrase)
+		if err != nil {
+			return migrated, err
+		}
+		profile.Token = value
+		profiles.Profiles[name] = profile
+		changed = true
+		migrated = append(migrated, "profile "+name)
+	}
+
+	if changed {
+		if err := config.WriteProfiles(profiles); err != nil {
+			return migrated, err
+		}
+	}
+	return migrated, nil
 }
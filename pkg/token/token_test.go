@@ -1,8 +1,11 @@
 package token
 
 import (
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/aziontech/azion-cli/pkg/config"
@@ -78,3 +81,99 @@ func Test_Save(t *testing.T) {
 		}
 	})
 }
+
+func Test_Remove(t *testing.T) {
+	config.SetPath(t.TempDir())
+	defer config.SetPath(".azion")
+
+	t.Run("remove saved token", func(t *testing.T) {
+		token, err := New(&Config{Out: io.Discard})
+		if err != nil {
+			t.Fatalf("New() = %v; want nil", err)
+		}
+		token.token = "TeST"
+		if err := token.Save(); err != nil {
+			t.Fatalf("Save() = %v; want nil", err)
+		}
+
+		location, err := Remove(config.DefaultProfile)
+		if err != nil || location == "" {
+			t.Fatalf("Remove() = %q, %v; want the credentials file", location, err)
+		}
+
+		location, err = Remove(config.DefaultProfile)
+		if err != nil || location != "" {
+			t.Fatalf("Remove() = %q, %v; want nothing to remove", location, err)
+		}
+	})
+}
+
+func Test_Encrypt(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		sealed, err := Encrypt("azionb43a9554776", "s3cret")
+		if err != nil {
+			t.Fatalf("Encrypt() = %v; want nil", err)
+		}
+		if !IsEncrypted(sealed) || strings.Contains(sealed, "azionb43a9554776") {
+			t.Fatalf("Encrypt() = %q; want an encrypted token", sealed)
+		}
+
+		plain, err := Decrypt(sealed, "s3cret")
+		if err != nil || plain != "azionb43a9554776" {
+			t.Fatalf("Decrypt() = %q, %v; want the token", plain, err)
+		}
+	})
+
+	t.Run("wrong passphrase", func(t *testing.T) {
+		sealed, _ := Encrypt("azionb43a9554776", "s3cret")
+		if _, err := Decrypt(sealed, "wrong"); err == nil {
+			t.Fatalf("Decrypt() = nil; want an error")
+		}
+	})
+
+	t.Run("passphrase required", func(t *testing.T) {
+		t.Setenv(PassphraseEnv, "")
+		sealed, _ := Encrypt("azionb43a9554776", "s3cret")
+		if _, err := Reveal(sealed, nil); err == nil {
+			t.Fatalf("Reveal() = nil; want an error")
+		}
+
+		plain, err := Reveal(sealed, func() (string, error) { return "s3cret", nil })
+		if err != nil || plain != "azionb43a9554776" {
+			t.Fatalf("Reveal() = %q, %v; want the token", plain, err)
+		}
+	})
+}
+
+func Test_Migrate(t *testing.T) {
+	dir := t.TempDir()
+	config.SetPath(dir)
+	defer config.SetPath(".azion")
+	t.Setenv(PassphraseEnv, "s3cret")
+
+	path := filepath.Join(dir, credentialsFilename)
+	if err := os.WriteFile(path, []byte("azionb43a9554776"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !WorldReadable() {
+		t.Fatalf("WorldReadable() = false; want true")
+	}
+
+	migrated, err := Migrate("s3cret")
+	if err != nil || len(migrated) != 1 {
+		t.Fatalf("Migrate() = %v, %v; want the credentials file", migrated, err)
+	}
+	if WorldReadable() {
+		t.Fatalf("WorldReadable() = true; want false")
+	}
+
+	tok, err := ReadFromDisk()
+	if err != nil || tok != "azionb43a9554776" {
+		t.Fatalf("ReadFromDisk() = %q, %v; want the token", tok, err)
+	}
+
+	migrated, err = Migrate("s3cret")
+	if err != nil || len(migrated) != 0 {
+		t.Fatalf("Migrate() = %v, %v; want nothing to migrate", migrated, err)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"gopkg.in/yaml.v2"
 
 	"github.com/aziontech/azion-cli/pkg/cmd/version"
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
 )
 
 const (
@@ -25,6 +26,9 @@ const (
 	repoURL      string = "https://github.com/aziontech/azion-cli.git"
 	LastActivity string = "LAST_ACTIVITY"
 	unknown      string = "unknown"
+
+	// defaultCheckInterval is the time between checks when update_check_interval isn't valid
+	defaultCheckInterval = 24 * time.Hour
 )
 
 // Variable factory for unit testing
@@ -38,8 +42,19 @@ var (
 	openConfigFunc       = openConfig
 )
 
-func UpdateBin() error {
-	notfy, err := notifyFunc()
+// UpdateBin offers to update the CLI when a newer version was published. It does nothing when the
+// update_check setting is false, and checks at most once each update_check_interval
+func UpdateBin(f *cmdutil.Factory) error {
+	if check, err := strconv.ParseBool(f.Config.GetString("update_check")); err == nil && !check {
+		return nil
+	}
+
+	interval := defaultCheckInterval
+	if d, err := time.ParseDuration(f.Config.GetString("update_check_interval")); err == nil && d > 0 {
+		interval = d
+	}
+
+	notfy, err := notifyFunc(interval)
 	if err != nil {
 		return err
 	}
@@ -171,17 +186,17 @@ func wantToUpdate() bool {
 	return result == "Yes"
 }
 
-func notify() (bool, error) {
+func notify(interval time.Duration) (bool, error) {
 	lastAct, err := getLastActivity()
 	if err != nil {
 		return false, err
 	}
 
-	// Difference is greater than or equal to half a day, notify user
+	// Difference is greater than or equal to the interval between checks, notify user
 	dateHour, _ := time.Parse(time.RFC3339, lastAct)
 	diff := time.Since(dateHour)
 
-	if diff >= 12*time.Hour {
+	if diff >= interval {
 		return true, nil
 	} else {
 		return false, nil
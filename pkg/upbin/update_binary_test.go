@@ -2,13 +2,17 @@ package upbin
 
 import (
 	"testing"
+	"time"
 
+	"github.com/aziontech/azion-cli/pkg/testutils"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateBin(t *testing.T) {
-	notifyFunc = func() (bool, error) {
+	var checkedInterval time.Duration
+	notifyFunc = func(interval time.Duration) (bool, error) {
+		checkedInterval = interval
 		return true, nil
 	}
 
@@ -40,8 +44,23 @@ func TestUpdateBin(t *testing.T) {
 		return nil
 	}
 
-	err := UpdateBin()
+	f, _, _ := testutils.NewFactory(nil)
+	f.Config.SetDefault("update_check", "true")
+	f.Config.SetDefault("update_check_interval", "6h")
+	err := UpdateBin(f)
 	assert.NoError(t, err)
+	assert.Equal(t, 6*time.Hour, checkedInterval)
+}
+
+func TestUpdateBinDisabled(t *testing.T) {
+	notifyFunc = func(interval time.Duration) (bool, error) {
+		t.Fatal("the version was checked with update_check set to false")
+		return false, nil
+	}
+
+	f, _, _ := testutils.NewFactory(nil)
+	f.Config.SetDefault("update_check", "false")
+	assert.NoError(t, UpdateBin(f))
 }
 
 type MockReferenceIter struct {
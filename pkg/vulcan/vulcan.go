@@ -10,3 +10,8 @@ const (
 func Command(flags, params string) string {
 	return fmt.Sprintf(installEdgeFunctions, flags, versionVulcan, params)
 }
+
+// Version returns the version of the edge-functions package used by the CLI
+func Version() string {
+	return versionVulcan
+}
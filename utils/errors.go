@@ -1,7 +1,10 @@
 package utils
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"net/http"
 )
 
 var (
@@ -17,14 +20,15 @@ var (
 	ErrorInternalServerError        = errors.New("The server could not process the request because an internal and unexpected problem occurred. Wait a few seconds and try again. For more information run the command again using the '--debug' flag. If the problem persists, contact Azion’s support")
 	ErrorUpdateNoFlagsSent          = errors.New("The subcommand update needs at least one flag with a valid value. Run the command `azion <command> update --help` to display more information and try again")
 	ErrorUnmarshalReader            = errors.New("Failed to decode the given 'azion.json' file. Verify if the file format is JSON or fix its content according to the JSON format specification at https://www.json.org/json-en.html")
-	ErrorFormatOut                  = errors.New("The server failed formatting data for display. Repeat the HTTP request and check the HTTP response's format")
-	ErrorWriteFile                  = errors.New("The file is read-only and/or isn't accessible. Change the attributes of the file to read and write and/or give access to it")
 	ErrorTokenManager               = errors.New("Internal token handling failure. Run 'azion configure --help' command to display more information and try again")
 	ErrorTokenNotProvided           = errors.New("Token was not provided; the CLI uses a previous stored token if it was configured. You must provide a valid token, or create a new one, and configure it to use with the CLI. Manage your personal tokens on RTM using the Account Menu > Personal Tokens and configure the token again with the command 'azion -t <token>'")
 	ErrorInvalidToken               = errors.New("The provided token is invalid. You must create a new token and configure it to use with the CLI. Manage your personal tokens on RTM using the Account Menu > Personal Tokens and configure the new token with the command 'azion -t <new_token>'")
 	ErrorToken401                   = errors.New("The token doesn't exist or has expired. Manage your personal tokens on RTM using the Account Menu > Personal Tokens and configure a valid token with the command 'azion -t <my_token>'")
 	ErrorForbidden403               = errors.New("You do not have the permissions to access the API. Make sure the feature is enabled in your profile")
 	ErrorNotFound404                = errors.New("The given ID or API's endpoint doesn't exist or isn't available. Check that the identifying information is correct")
+	ErrorConflict409                = errors.New("The request conflicts with the current state of the resource. Verify the resource with 'azion describe' and try again")
+	ErrorRateLimited429             = errors.New("The Azion API received too many requests from your account. Wait a few seconds and try the command again")
+	ErrorNetwork                    = errors.New("Failed to reach the Azion API: %s. Verify your connection, proxy and ca_bundle settings and try again")
 	ErrorFetchingTemplates          = errors.New("Failed to fetch templates from the Azion's GitHub remote repository. Verify the connectivity to the repository https://github.com/aziontech/azioncli-template and try again")
 	ErrorMovingFiles                = errors.New("Failed to initialize your project with the Azion template. Please verify if you have write permissions to this directory")
 	ErrorUnsupportedType            = errors.New("The project type isn’t supported. Modify the project to a valid type nextjs and try the command again. Use the flags -h or --help with a command or subcommand to display more information and try again")
@@ -47,5 +51,131 @@ var (
 	ErrorParseResponse              = errors.New("Failed to parse your response. Check your response and try again. If the error persists, contact Azion support")
 	ErrorMinTlsVersion              = errors.New("This is not a valid TLS Version. Run azion edge_applications <subcommand> --help for more information")
 	ErrorNameInUse                  = errors.New("The name you've selected is already in use by another resource. Please choose a different name. Run 'azion list [resource]' to see all your resources")
+	ErrorInvalidPackageManager      = errors.New("The package manager '%s' isn't supported. Use one of: %s")
+	ErrorPackageManagerNotFound     = errors.New("The package manager '%s' wasn't found. Install it or send another one with the flag --package-manager and try again")
+	ErrorInstallingDeps             = errors.New("Failed to install project dependencies")
+	ErrorEnvVarLine                 = errors.New("Line %d isn't in the KEY=VALUE format")
+	ErrorReadingProfiles            = errors.New("Failed to read the profiles.yaml file of the CLI's configuration directory. Verify if the file has a valid YAML format and try again")
+	ErrorProfileNotFound            = errors.New("The profile '%s' doesn't exist. Run 'azion profile list' to see the available profiles or 'azion profile add' to create it")
+	ErrorReadingSettings            = errors.New("Failed to read the config.yaml file of the CLI's configuration directory. Verify its syntax and permissions and try again")
+	ErrorHTTPTransport              = errors.New("Failed to configure the connection to the Azion APIs: %s. Verify the proxy and ca_bundle settings and try again")
+	ErrorOpeningLogFile             = errors.New("Failed to open the log file %s: %s. Verify the path and its permissions and try again")
+	ErrorOpeningTraceFile           = errors.New("Failed to open the HTTP trace file %s. Verify the path and its permissions and try again")
+	ErrorMissingFlag                = errors.New("The flag --%s is required because the command can't ask for this information: input is disabled by --no-input or isn't a terminal. Send the flag and try again")
 	ErrorCancelledContextInput      = errors.New("Execution interrupted by the user. All interactions of this flow were lost.")
 )
+
+// Code classifies an Error. Each code has its own exit code, see ExitCode
+type Code string
+
+const (
+	CodeAuth        Code = "auth"
+	CodeNotFound    Code = "not_found"
+	CodeValidation  Code = "validation"
+	CodeConflict    Code = "conflict"
+	CodeNetwork     Code = "network"
+	CodeRateLimited Code = "rate_limited"
+	CodeInternal    Code = "internal"
+	// CodeDrift is the code of the diff command when the account differs from the manifest
+	CodeDrift Code = "drift"
+	// CodeUnknown is the code of errors that aren't an Error
+	CodeUnknown Code = "error"
+)
+
+// Exit codes of the CLI. Errors that aren't an Error exit with ExitError
+const (
+	ExitOK          = 0
+	ExitError       = 1
+	ExitValidation  = 2
+	ExitAuth        = 3
+	ExitNotFound    = 4
+	ExitConflict    = 5
+	ExitRateLimited = 6
+	ExitNetwork     = 7
+	ExitInternal    = 8
+	ExitDrift       = 9
+)
+
+var exitCodes = map[Code]int{
+	CodeValidation:  ExitValidation,
+	CodeAuth:        ExitAuth,
+	CodeNotFound:    ExitNotFound,
+	CodeConflict:    ExitConflict,
+	CodeRateLimited: ExitRateLimited,
+	CodeNetwork:     ExitNetwork,
+	CodeInternal:    ExitInternal,
+	CodeDrift:       ExitDrift,
+}
+
+// Error is an error with a code and, for errors of the Azion API, the HTTP status and the ID of the request
+type Error struct {
+	Code      Code
+	Status    int
+	RequestID string
+	Err       error
+}
+
+// NewError returns an Error of code for err
+func NewError(code Code, err error) *Error {
+	return &Error{Code: code, Err: err}
+}
+
+// newAPIError returns an Error of code for err with the status and request ID of the response
+func newAPIError(code Code, httpResp *http.Response, err error) *Error {
+	e := NewError(code, err)
+	if httpResp != nil {
+		e.Status = httpResp.StatusCode
+		e.RequestID = httpResp.Header.Get("X-Request-Id")
+	}
+	return e
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON writes the error as the object printed on stderr by commands run with --format json
+func (e *Error) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Code      Code   `json:"code"`
+		Message   string `json:"message"`
+		Status    int    `json:"status,omitempty"`
+		RequestID string `json:"request_id,omitempty"`
+		ExitCode  int    `json:"exit_code"`
+	}{e.Code, e.Error(), e.Status, e.RequestID, ExitCode(e)})
+}
+
+// AsError returns the Error of err, or an Error of CodeUnknown when err doesn't carry one
+func AsError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return NewError(CodeUnknown, err)
+}
+
+// ExitCode returns the exit code of the CLI for err
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+	if code, ok := exitCodes[AsError(err).Code]; ok {
+		return code
+	}
+	return ExitError
+}
+
+// WrapError formats the message of msgErr with err, as fmt.Errorf(msgErr.Error(), err) does, keeping
+// the code, status and request ID of err
+func WrapError(msgErr error, err error) error {
+	wrapped := fmt.Errorf(msgErr.Error(), err)
+	var e *Error
+	if !errors.As(err, &e) {
+		return wrapped
+	}
+	return &Error{Code: e.Code, Status: e.Status, RequestID: e.RequestID, Err: wrapped}
+}
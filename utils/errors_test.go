@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorPerStatusCode(t *testing.T) {
+	response := func(status int, body string) *http.Response {
+		header := http.Header{}
+		header.Set("X-Request-Id", "req-123")
+		return &http.Response{StatusCode: status, Header: header, Body: io.NopCloser(strings.NewReader(body))}
+	}
+
+	tests := []struct {
+		name     string
+		resp     *http.Response
+		err      error
+		code     Code
+		exitCode int
+		message  string
+	}{
+		{name: "unauthorized", resp: response(401, ""), code: CodeAuth, exitCode: ExitAuth, message: ErrorToken401.Error()},
+		{name: "forbidden", resp: response(403, ""), code: CodeAuth, exitCode: ExitAuth, message: ErrorForbidden403.Error()},
+		{name: "not found", resp: response(404, ""), code: CodeNotFound, exitCode: ExitNotFound, message: ErrorNotFound404.Error()},
+		{name: "rate limited", resp: response(429, ""), code: CodeRateLimited, exitCode: ExitRateLimited, message: ErrorRateLimited429.Error()},
+		{name: "internal", resp: response(502, ""), code: CodeInternal, exitCode: ExitInternal, message: ErrorInternalServerError.Error()},
+		{name: "detail", resp: response(400, `{"detail": "invalid page"}`), code: CodeValidation, exitCode: ExitValidation, message: "invalid page"},
+		{name: "nested key", resp: response(400, `{"errors": [{"name_already_in_use": "my-app"}]}`), code: CodeConflict, exitCode: ExitConflict, message: ErrorNameInUse.Error()},
+		{name: "key in a value isn't a match", resp: response(400, `{"message": "detail"}`), code: CodeValidation, exitCode: ExitValidation, message: `{"message": "detail"}`},
+		{name: "conflict", resp: response(409, "busy"), code: CodeConflict, exitCode: ExitConflict, message: "busy"},
+		{name: "timeout", err: errors.New("Client.Timeout exceeded"), code: CodeNetwork, exitCode: ExitNetwork, message: ErrorTimeoutAPICall.Error()},
+		{name: "no response", err: errors.New("connection refused"), code: CodeNetwork, exitCode: ExitNetwork, message: fmt.Sprintf(ErrorNetwork.Error(), "connection refused")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ErrorPerStatusCode(tt.resp, tt.err)
+			require.EqualError(t, err, tt.message)
+			require.Equal(t, tt.code, AsError(err).Code)
+			require.Equal(t, tt.exitCode, ExitCode(err))
+			if tt.resp != nil {
+				require.Equal(t, tt.resp.StatusCode, AsError(err).Status)
+				require.Equal(t, "req-123", AsError(err).RequestID)
+			}
+		})
+	}
+
+	t.Run("sentinel errors are kept", func(t *testing.T) {
+		require.ErrorIs(t, ErrorPerStatusCode(response(404, ""), nil), ErrorNotFound404)
+	})
+}
+
+func TestWrapError(t *testing.T) {
+	msgErr := errors.New("Failed to describe the resource: %s")
+
+	err := WrapError(msgErr, ErrorPerStatusCode(&http.Response{StatusCode: 404}, nil))
+	require.EqualError(t, err, "Failed to describe the resource: "+ErrorNotFound404.Error())
+	require.Equal(t, ExitNotFound, ExitCode(err))
+
+	err = WrapError(msgErr, errors.New("boom"))
+	require.EqualError(t, err, "Failed to describe the resource: boom")
+	require.Equal(t, ExitError, ExitCode(err))
+
+	data, err := json.Marshal(AsError(err))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"code": "error", "message": "Failed to describe the resource: boom", "exit_code": 1}`, string(data))
+}
@@ -3,20 +3,30 @@ package utils
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"net/http"
 	"os"
 	"os/exec"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/AlecAivazis/survey/v2/terminal"
 
+	msg "github.com/aziontech/azion-cli/messages/general"
+	"github.com/aziontech/azion-cli/pkg/api/client"
 	"github.com/aziontech/azion-cli/pkg/cmdutil"
+	"github.com/aziontech/azion-cli/pkg/config"
 	"github.com/aziontech/azion-cli/pkg/contracts"
 	"github.com/aziontech/azion-cli/pkg/logger"
 	"github.com/tidwall/gjson"
@@ -35,6 +45,86 @@ func CleanDirectory(dir string) error {
 	return nil
 }
 
+// CopyDirectory copies the contents of src into dst, preserving file modes.
+// Files and directories whose name is in skip are not copied
+func CopyDirectory(src, dst string, skip ...string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		for _, name := range skip {
+			if info.Name() == name && path != src {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, info.Mode().Perm())
+	})
+}
+
+// DirChecksum returns a SHA-256 checksum of the relative paths and contents of the files in dir.
+// Files and directories named in skip are ignored
+func DirChecksum(dir string, skip ...string) (string, error) {
+	hash := sha256.New()
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		for _, name := range skip {
+			if info.Name() == name && path != dir {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		// filepath.Walk goes through the files in lexical order, so the checksum is stable
+		hash.Write([]byte(filepath.ToSlash(rel)))
+		hash.Write([]byte{0})
+		hash.Write(data)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
 func IsDirEmpty(dir string) (bool, error) {
 	f, err := os.Open(dir)
 	if err != nil {
@@ -74,8 +164,16 @@ func LoadEnvVarsFromFile(varsFileName string) ([]string, error) {
 	fileScan := bufio.NewScanner(f)
 	fileVars := make([]string, 0)
 
-	for fileScan.Scan() {
-		fileVars = append(fileVars, fileScan.Text())
+	for n := 1; fileScan.Scan(); n++ {
+		line := strings.TrimSpace(fileScan.Text())
+		// skip blank lines and comments
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.Index(line, "=") < 1 {
+			return nil, fmt.Errorf(ErrorEnvVarLine.Error(), n)
+		}
+		fileVars = append(fileVars, line)
 	}
 
 	if err := fileScan.Err(); err != nil {
@@ -128,7 +226,16 @@ func CommandRunInteractiveWithOutput(f *cmdutil.Factory, comm string, envVars []
 
 // CommandRunInteractive runs a command interactively.
 func CommandRunInteractive(f *cmdutil.Factory, comm string) error {
+	return CommandRunInteractiveWithEnv(f, comm, nil)
+}
+
+// CommandRunInteractiveWithEnv runs a command interactively, adding envVars to the current environment
+func CommandRunInteractiveWithEnv(f *cmdutil.Factory, comm string, envVars []string) error {
 	cmd := exec.Command(shell, "-c", comm)
+	if len(envVars) > 0 {
+		cmd.Env = os.Environ()
+		cmd.Env = append(cmd.Env, envVars...)
+	}
 
 	if !f.Silent {
 		cmd.Stdin = f.IOStreams.In
@@ -178,6 +285,23 @@ func RunCommandStreamOutput(out io.Writer, envVars []string, comm string) error
 	return nil
 }
 
+// RedactEnvVars returns a copy of envVars in the KEY=VALUE format with all values hidden,
+// so they can be safely written to logs
+func RedactEnvVars(envVars []string) []string {
+	redacted := make([]string, 0, len(envVars))
+	for _, env := range envVars {
+		key := strings.SplitN(env, "=", 2)[0]
+		redacted = append(redacted, key+"=****")
+	}
+	return redacted
+}
+
+// NotifyInterrupt returns a copy of parent that is cancelled when the process receives an interrupt
+// or termination signal. Calling stop releases the signals
+func NotifyInterrupt(parent context.Context) (ctx context.Context, stop context.CancelFunc) {
+	return signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+}
+
 func GetWorkingDir() (string, error) {
 	pathWorkingDir, err := os.Getwd()
 	if err != nil {
@@ -269,30 +393,47 @@ func WriteAzionJsonContent(conf *contracts.AzionApplicationOptions) error {
 	return nil
 }
 
-// Returns the correct error message for each HTTP Status code
+// ErrorPerStatusCode returns the Error of a failed request to the Azion API, with the message and code
+// of its HTTP status and the messages the API sends in the body of 400, 409 and 422 responses
 func ErrorPerStatusCode(httpResp *http.Response, err error) error {
 
 	// when the CLI times out, probably due to SSO communication, httpResp is null and/or http status is 500;
 	// that's why we need this verification first
-	if httpResp == nil || httpResp.StatusCode >= 500 {
-		return checkStatusCode500Error(err)
+	if httpResp == nil {
+		// errors of the CLI itself, such as a saved token it can't decrypt, keep their code
+		var e *Error
+		if errors.As(err, &e) {
+			return e
+		}
+		if err != nil && strings.Contains(err.Error(), "Client.Timeout") {
+			return newAPIError(CodeNetwork, nil, ErrorTimeoutAPICall)
+		}
+		return newAPIError(CodeNetwork, nil, fmt.Errorf(ErrorNetwork.Error(), err))
 	}
 
 	statusCode := httpResp.StatusCode
 
-	switch statusCode {
+	switch {
+	case statusCode >= 500:
+		return newAPIError(CodeInternal, httpResp, ErrorInternalServerError)
+
+	case statusCode == 400, statusCode == 409, statusCode == 422:
+		return checkResponseBody(httpResp)
 
-	case 400:
-		return checkStatusCode400Error(httpResp)
+	case statusCode == 401:
+		return newAPIError(CodeAuth, httpResp, ErrorToken401)
 
-	case 401:
-		return ErrorToken401
+	case statusCode == 403:
+		return newAPIError(CodeAuth, httpResp, ErrorForbidden403)
 
-	case 403:
-		return ErrorForbidden403
+	case statusCode == 404:
+		return newAPIError(CodeNotFound, httpResp, ErrorNotFound404)
 
-	case 404:
-		return ErrorNotFound404
+	case statusCode == 429:
+		return newAPIError(CodeRateLimited, httpResp, ErrorRateLimited429)
+
+	case statusCode >= 400 && err != nil:
+		return newAPIError(CodeValidation, httpResp, err)
 
 	default:
 		return err
@@ -300,105 +441,226 @@ func ErrorPerStatusCode(httpResp *http.Response, err error) error {
 	}
 }
 
-// checks varying errors that may occur when status code is 500
-func checkStatusCode500Error(err error) error {
-
-	if strings.Contains(err.Error(), "Client.Timeout") {
-		return ErrorTimeoutAPICall
+// checkResponseBody reads the JSON body of the response and returns the error of the first key the API
+// uses to explain it, or the body itself when there is none
+func checkResponseBody(httpResp *http.Response) error {
+	code := CodeValidation
+	if httpResp.StatusCode == http.StatusConflict {
+		code = CodeConflict
 	}
 
-	return ErrorInternalServerError
-}
-
-// read the body of the response and returns a personalized error or the body if the error is not identified
-func checkStatusCode400Error(httpResp *http.Response) error {
 	responseBody, _ := io.ReadAll(httpResp.Body)
-	if err := checkNoProduct(string(responseBody)); err != nil {
-		return err
+	body := gjson.ParseBytes(responseBody)
+
+	if product, ok := findKey(body, "user_has_no_product"); ok {
+		return newAPIError(CodeAuth, httpResp, fmt.Errorf("%w: %s", ErrorProductNotOwned, product.String()))
 	}
-	if err := checkTlsVersion(string(responseBody)); err != nil {
-		return err
+	if _, ok := findKey(body, "minimum_tls_version"); ok {
+		return newAPIError(code, httpResp, ErrorMinTlsVersion)
 	}
-	if err := checkOriginlessCacheSettings(string(responseBody)); err != nil {
-		return err
+	if message, ok := findKey(body, "originless_cache_settings"); ok {
+		return newAPIError(code, httpResp, fmt.Errorf("%s", message.String()))
 	}
-	if err := checkDetail(string(responseBody)); err != nil {
-		return err
+	if message, ok := findKey(body, "detail"); ok {
+		return newAPIError(code, httpResp, fmt.Errorf("%s", message.String()))
 	}
-	if err := checkOrderField(string(responseBody)); err != nil {
-		return err
+	if message, ok := findKey(body, "invalid_order_field"); ok {
+		return newAPIError(code, httpResp, fmt.Errorf("%s", message.String()))
 	}
-	if err := checkNameInUse(string(responseBody)); err != nil {
-		return err
+	if _, ok := findKey(body, "name_already_in_use"); ok {
+		return newAPIError(CodeConflict, httpResp, ErrorNameInUse)
 	}
 
-	return fmt.Errorf("%s", string(responseBody))
+	return newAPIError(code, httpResp, fmt.Errorf("%s", string(responseBody)))
 }
 
-func checkNoProduct(body string) error {
-	if strings.Contains(body, "user_has_no_product") {
-		product := gjson.Get(body, "user_has_no_product")
-		return fmt.Errorf("%w: %s", ErrorProductNotOwned, product.String())
-	}
-	return nil
+// findKey returns the value of the first key named key in a JSON value, looking into nested objects
+// and lists
+func findKey(value gjson.Result, key string) (gjson.Result, bool) {
+	var (
+		found gjson.Result
+		ok    bool
+	)
+	if !value.IsObject() && !value.IsArray() {
+		return found, false
+	}
+	value.ForEach(func(k, v gjson.Result) bool {
+		if value.IsObject() && k.String() == key {
+			found, ok = v, true
+		} else {
+			found, ok = findKey(v, key)
+		}
+		return !ok
+	})
+	return found, ok
+}
+
+func CreateVersionID() string {
+	return time.Now().Format("20060102150405")
+}
+
+// IsEmpty returns true when the string is empty
+func IsEmpty(str string) bool {
+	return len(str) < 1
 }
 
-func checkOriginlessCacheSettings(body string) error {
-	if strings.Contains(body, "originless_cache_settings") {
-		msgorigin := gjson.Get(body, "originless_cache_settings")
-		return fmt.Errorf("%s", msgorigin.String())
+// PackageManagers are the package managers supported to install the project dependencies
+var PackageManagers = []string{"npm", "yarn", "pnpm", "bun"}
+
+// lockfiles maps the lockfile of each package manager, in the order they are looked up
+var lockfiles = []struct {
+	file    string
+	manager string
+}{
+	{"pnpm-lock.yaml", "pnpm"},
+	{"pnpm-workspace.yaml", "pnpm"},
+	{"bun.lockb", "bun"},
+	{"bun.lock", "bun"},
+	{"yarn.lock", "yarn"},
+	{"package-lock.json", "npm"},
+}
+
+// ValidatePackageManager returns an error if the package manager isn't supported
+func ValidatePackageManager(manager string) error {
+	for _, pm := range PackageManagers {
+		if pm == manager {
+			return nil
+		}
 	}
-	return nil
+	return fmt.Errorf(ErrorInvalidPackageManager.Error(), manager, strings.Join(PackageManagers, ", "))
 }
 
-func checkTlsVersion(body string) error {
-	if strings.Contains(body, "minimum_tls_version") {
-		return ErrorMinTlsVersion
+// FindLockfile returns the first lockfile found in dir and the package manager that owns it.
+// Both are empty when the project has no lockfile
+func FindLockfile(dir string, stat func(path string) (fs.FileInfo, error)) (file, manager string) {
+	for _, lf := range lockfiles {
+		if _, err := stat(filepath.Join(dir, lf.file)); err == nil {
+			return lf.file, lf.manager
+		}
 	}
-	return nil
+	return "", ""
 }
 
-func checkNameInUse(body string) error {
-	if strings.Contains(body, "name_already_in_use") {
-		return ErrorNameInUse
+// DetectPackageManager returns the package manager declared in the 'packageManager' field of package.json
+// or, when it is missing, the one that owns the lockfile in dir. It returns an empty string if none is found
+func DetectPackageManager(dir string, stat func(path string) (fs.FileInfo, error), readFile func(path string) ([]byte, error)) string {
+	if data, err := readFile(filepath.Join(dir, "package.json")); err == nil {
+		pkg := struct {
+			PackageManager string `json:"packageManager"`
+		}{}
+		if err := json.Unmarshal(data, &pkg); err == nil && pkg.PackageManager != "" {
+			name := strings.SplitN(pkg.PackageManager, "@", 2)[0]
+			if ValidatePackageManager(name) == nil {
+				return name
+			}
+		}
 	}
-	return nil
+
+	_, manager := FindLockfile(dir, stat)
+	return manager
 }
 
-func checkDetail(body string) error {
-	if strings.Contains(body, "detail") {
-		msgDetail := gjson.Get(body, "detail")
-		return fmt.Errorf("%s", msgDetail.String())
+// ResolvePackageManager picks the package manager used to install the project dependencies in dir.
+// The flag has precedence, followed by the one used by the project, the package_manager setting
+// and, at last, the user's choice, which is saved as the setting
+func ResolvePackageManager(f *cmdutil.Factory, flag, dir string, stat func(path string) (fs.FileInfo, error), readFile func(path string) ([]byte, error)) (string, error) {
+	if flag != "" {
+		return flag, ValidatePackageManager(flag)
 	}
-	return nil
+
+	if manager := DetectPackageManager(dir, stat, readFile); manager != "" {
+		logger.Debug("Package manager detected from the project", zap.String("manager", manager))
+		return manager, nil
+	}
+
+	if manager := f.Config.GetString("package_manager"); manager != "" {
+		logger.Debug("Package manager read from the settings", zap.String("manager", manager))
+		return manager, ValidatePackageManager(manager)
+	}
+
+	if !f.CanPrompt() {
+		return "", fmt.Errorf(ErrorMissingFlag.Error(), "package-manager")
+	}
+
+	manager, err := GetPackageManager()
+	if err != nil {
+		return "", err
+	}
+
+	if err := config.SaveSetting("package_manager", manager); err != nil {
+		logger.Debug("Error while saving the package manager setting", zap.Error(err))
+	}
+
+	return manager, nil
 }
 
-func checkOrderField(body string) error {
-	if strings.Contains(body, "invalid_order_field") {
-		msgDetail := gjson.Get(body, "invalid_order_field")
-		return fmt.Errorf("%s", msgDetail.String())
+// Confirm answers a yes/no question with the value of its flag when it was sent, with yes when
+// --yes was sent or, otherwise, with the answer to ask
+func Confirm(f *cmdutil.Factory, flag *bool, flagName string, ask func() (bool, error)) (bool, error) {
+	if flag != nil {
+		return *flag, nil
 	}
-	return nil
+	if f.GlobalFlagAll {
+		return true, nil
+	}
+	if !f.CanPrompt() {
+		return false, fmt.Errorf(ErrorMissingFlag.Error(), flagName)
+	}
+	return ask()
 }
 
-func CreateVersionID() string {
-	return time.Now().Format("20060102150405")
+// DepsInstaller installs the dependencies of the project in Dir once, if the user wants to.
+// Its functions are the ones of the command that uses it
+type DepsInstaller struct {
+	F              *cmdutil.Factory
+	Dir            string
+	PackageManager string
+	// Flag is the value of --install-deps, or nil when it wasn't sent
+	Flag           *bool
+	LookPath       func(bin string) (string, error)
+	RunInteractive func(f *cmdutil.Factory, comm string) error
+	Stat           func(path string) (fs.FileInfo, error)
+	FileReader     func(path string) ([]byte, error)
+	Ask            func(question string) (bool, error)
+	installed      bool
 }
 
-func TruncateString(str string) string {
-	if len(str) > 30 {
-		return str[:30] + "..."
+// Install installs the dependencies, unless they were already installed or the user answers no to question
+func (d *DepsInstaller) Install(question string) error {
+	if d.installed {
+		return nil
 	}
-	return str
-}
 
-// IsEmpty returns true when the string is empty
-func IsEmpty(str string) bool {
-	return len(str) < 1
+	install, err := Confirm(d.F, d.Flag, "install-deps", func() (bool, error) {
+		return d.Ask(question)
+	})
+	if err != nil || !install {
+		return err
+	}
+
+	packageManager, err := ResolvePackageManager(d.F, d.PackageManager, d.Dir, d.Stat, d.FileReader)
+	if err != nil {
+		return err
+	}
+
+	if _, err := d.LookPath(packageManager); err != nil {
+		logger.Debug("Package manager not found", zap.Error(err))
+		return fmt.Errorf(ErrorPackageManagerNotFound.Error(), packageManager)
+	}
+
+	logger.FInfo(d.F.IOStreams.Out, fmt.Sprintf(msg.InstallingDeps, packageManager))
+	err = d.RunInteractive(d.F, fmt.Sprintf("%s install", packageManager))
+	if err != nil {
+		logger.Debug("Error while installing project dependencies", zap.Error(err))
+		return ErrorInstallingDeps
+	}
+	d.installed = true
+
+	return nil
 }
 
 func GetPackageManager() (string, error) {
-	opts := []string{"npm", "yarn"}
+	opts := PackageManagers
 	answer := ""
 	prompt := &survey.Select{
 		Message: "Choose a package manager:",
@@ -434,18 +696,40 @@ func AskInput(msg string) (string, error) {
 	return answer, nil
 }
 
+// AskPassword asks for a secret without echoing it
+func AskPassword(msg string) (string, error) {
+	qs := []*survey.Question{
+		{
+			Name:     "password",
+			Prompt:   &survey.Password{Message: msg},
+			Validate: survey.Required,
+		},
+	}
+
+	answer := ""
+
+	err := survey.Ask(qs, &answer)
+	if err == terminal.InterruptErr {
+		logger.Error(ErrorCancelledContextInput.Error())
+		os.Exit(0)
+	} else if err != nil {
+		logger.Debug("Error while parsing answer", zap.Error(err))
+		return "", ErrorParseResponse
+	}
+
+	return answer, nil
+}
+
 func LogAndRewindBody(httpResp *http.Response) error {
 	logger.Debug("", zap.Any("Status Code", httpResp.StatusCode))
-	logger.Debug("", zap.Any("Headers", httpResp.Header))
+	logger.Debug("", zap.Any("Headers", client.RedactHeaders(httpResp.Header)))
 	bodyBytes, err := io.ReadAll(httpResp.Body)
 	if err != nil {
 		logger.Debug("Error while reading body of the http response", zap.Error(err))
 		return ErrorPerStatusCode(httpResp, err)
 	}
 
-	// Convert the body bytes to string
-	bodyString := string(bodyBytes)
-	logger.Debug("", zap.Any("Body", bodyString))
+	logger.Debug("", zap.Any("Body", client.RedactBody(bodyBytes)))
 
 	// Rewind the response body to the beginning
 	httpResp.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
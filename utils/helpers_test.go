@@ -1,12 +1,22 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/aziontech/azion-cli/pkg/cmdutil"
 	"github.com/aziontech/azion-cli/pkg/contracts"
+	"github.com/aziontech/azion-cli/pkg/httpmock"
+	"github.com/aziontech/azion-cli/pkg/logger"
+	"github.com/aziontech/azion-cli/pkg/testutils"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
 )
 
 func TestCobraCmd(t *testing.T) {
@@ -52,6 +62,30 @@ func TestCobraCmd(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("load env from file vars skipping comments", func(t *testing.T) {
+		_ = os.MkdirAll("/tmp/ThisIsAzionCliFileVarTest", os.ModePerm)
+
+		data := []byte("# comment\nVAR1=test1\n\nVAR2=test2\n")
+		_ = os.WriteFile("/tmp/ThisIsAzionCliFileVarTest/comments.txt", data, 0644)
+
+		envs, err := LoadEnvVarsFromFile("/tmp/ThisIsAzionCliFileVarTest/comments.txt")
+		require.NoError(t, err)
+		require.Equal(t, []string{"VAR1=test1", "VAR2=test2"}, envs)
+	})
+
+	t.Run("load env from malformed file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "malformed.env")
+		_ = os.WriteFile(path, []byte("# comment\nVAR1=test1\nVAR2\n"), 0644)
+
+		_, err := LoadEnvVarsFromFile(path)
+		require.EqualError(t, err, "Line 3 isn't in the KEY=VALUE format")
+	})
+
+	t.Run("redact env vars", func(t *testing.T) {
+		redacted := RedactEnvVars([]string{"API_TOKEN=abc123", "EMPTY="})
+		require.Equal(t, []string{"API_TOKEN=****", "EMPTY=****"}, redacted)
+	})
+
 	t.Run("write json content", func(t *testing.T) {
 		path, _ := GetWorkingDir()
 
@@ -88,8 +122,89 @@ func TestCobraCmd(t *testing.T) {
 
 	t.Run("returns invalid order_by", func(t *testing.T) {
 		body := `{"invalid_order_field":"'edge_domain' is not a valid option for 'order_by'","available_order_fields":["id","name","cnames","cname_access_only","digital_certificate_id","edge_application_id","is_active"]}`
-		err := checkOrderField(body)
+		err := ErrorPerStatusCode(&http.Response{StatusCode: 400, Body: io.NopCloser(strings.NewReader(body))}, nil)
 
 		require.Equal(t, `'edge_domain' is not a valid option for 'order_by'`, err.Error())
+		require.Equal(t, ExitValidation, ExitCode(err))
+	})
+	t.Run("detect package manager from lockfile", func(t *testing.T) {
+		dir := t.TempDir()
+		require.Equal(t, "", DetectPackageManager(dir, os.Stat, os.ReadFile))
+
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "pnpm-lock.yaml"), []byte(""), 0644))
+		require.Equal(t, "pnpm", DetectPackageManager(dir, os.Stat, os.ReadFile))
+	})
+
+	t.Run("detect package manager from package.json", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "yarn.lock"), []byte(""), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "package.json"), []byte(`{"packageManager": "bun@1.0.0"}`), 0644))
+
+		require.Equal(t, "bun", DetectPackageManager(dir, os.Stat, os.ReadFile))
+	})
+
+	t.Run("invalid package manager", func(t *testing.T) {
+		require.NoError(t, ValidatePackageManager("pnpm"))
+		require.Error(t, ValidatePackageManager("pip"))
+	})
+
+	t.Run("confirm without input names the flag", func(t *testing.T) {
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		ask := func() (bool, error) { return false, errors.New("asked") }
+
+		_, err := Confirm(f, nil, "deploy", ask)
+		require.EqualError(t, err, fmt.Sprintf(ErrorMissingFlag.Error(), "deploy"))
+
+		deploy := false
+		ok, err := Confirm(f, &deploy, "deploy", ask)
+		require.NoError(t, err)
+		require.False(t, ok)
+
+		f.GlobalFlagAll = true
+		ok, err = Confirm(f, nil, "deploy", ask)
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+
+	t.Run("install dependencies once", func(t *testing.T) {
+		logger.New(zapcore.DebugLevel)
+		f, stdout, _ := testutils.NewFactory(&httpmock.Registry{})
+		f.GlobalFlagAll = true
+		var commands []string
+		deps := &DepsInstaller{
+			F:              f,
+			Dir:            t.TempDir(),
+			PackageManager: "pnpm",
+			LookPath:       func(bin string) (string, error) { return bin, nil },
+			RunInteractive: func(f *cmdutil.Factory, comm string) error {
+				commands = append(commands, comm)
+				return nil
+			},
+			Stat:       os.Stat,
+			FileReader: os.ReadFile,
+		}
+
+		require.NoError(t, deps.Install("Install?"))
+		require.NoError(t, deps.Install("Install?"))
+		require.Equal(t, []string{"pnpm install"}, commands)
+		require.Equal(t, "Installing application dependencies with pnpm\n", stdout.String())
+	})
+
+	t.Run("install dependencies without the package manager", func(t *testing.T) {
+		logger.New(zapcore.DebugLevel)
+		f, _, _ := testutils.NewFactory(&httpmock.Registry{})
+		install := true
+		deps := &DepsInstaller{
+			F:              f,
+			Dir:            t.TempDir(),
+			PackageManager: "yarn",
+			Flag:           &install,
+			LookPath:       func(bin string) (string, error) { return "", errors.New("not found") },
+			Stat:           os.Stat,
+			FileReader:     os.ReadFile,
+		}
+
+		err := deps.Install("Install?")
+		require.EqualError(t, err, fmt.Sprintf(ErrorPackageManagerNotFound.Error(), "yarn"))
 	})
 }